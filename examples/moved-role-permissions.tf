@@ -0,0 +1,47 @@
+terraform {
+  required_providers {
+    berth = {
+      source  = "registry.terraform.io/tech-arch1tect/berth"
+      version = "0.1.0"
+    }
+  }
+}
+
+provider "berth" {
+  url                  = "https://localhost:4443"
+  api_key              = var.berth_api_key
+  insecure_skip_verify = true
+}
+
+variable "berth_api_key" {
+  description = "Berth API key"
+  type        = string
+  sensitive   = true
+}
+
+# Splitting a berth_role that declared two rules into one berth_role_permission per rule.
+# A moved block carries exactly one rule: the first ordered by server ID, permission
+# name and stack pattern. The plan prints the import IDs of the rules that were not moved.
+moved {
+  from = berth_role.deployers
+  to   = berth_role_permission.deployers_stacks_read
+}
+
+import {
+  to = berth_role_permission.deployers_logs_read
+  id = "3:12"
+}
+
+resource "berth_role_permission" "deployers_stacks_read" {
+  role_id         = 3
+  server_id       = 1
+  permission_name = "stacks.read"
+  stack_pattern   = "*"
+}
+
+resource "berth_role_permission" "deployers_logs_read" {
+  role_id         = 3
+  server_id       = 2
+  permission_name = "logs.read"
+  stack_pattern   = "app-*"
+}
@@ -0,0 +1,1206 @@
+package berthtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	APIKey  = "berthtest-api-key"
+	Version = "1.0.0-berthtest"
+
+	composeFile = "docker-compose.yml"
+)
+
+type Role struct {
+	ID          int32  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	IsAdmin     bool   `json:"is_admin"`
+}
+
+type Permission struct {
+	ID           int32  `json:"id"`
+	Name         string `json:"name"`
+	Resource     string `json:"resource"`
+	Action       string `json:"action"`
+	Description  string `json:"description"`
+	IsAPIKeyOnly bool   `json:"is_api_key_only"`
+}
+
+type Rule struct {
+	ID           int32  `json:"id"`
+	PermissionID int32  `json:"permission_id"`
+	ServerID     int32  `json:"server_id"`
+	StackPattern string `json:"stack_pattern"`
+	IsStackBased bool   `json:"is_stack_based"`
+}
+
+type Server struct {
+	ID                  int32  `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	Host                string `json:"host"`
+	Port                int32  `json:"port"`
+	IsActive            bool   `json:"is_active"`
+	SkipSSLVerification bool   `json:"skip_ssl_verification"`
+	CreatedAt           string `json:"created_at"`
+	UpdatedAt           string `json:"updated_at"`
+}
+
+type User struct {
+	ID        int32   `json:"id"`
+	Username  string  `json:"username"`
+	Email     string  `json:"email"`
+	Password  string  `json:"-"`
+	RoleIDs   []int32 `json:"-"`
+	CreatedAt string  `json:"created_at"`
+}
+
+type Stack struct {
+	Name     string
+	Services []Service
+	Compose  map[string]string
+}
+
+type Service struct {
+	Name       string
+	Image      string
+	Containers []Container
+}
+
+type Container struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+	State string `json:"state"`
+}
+
+var DefaultPermissions = []Permission{
+	{Name: "stacks.read", Resource: "stacks", Action: "read", Description: "View stacks"},
+	{Name: "stacks.manage", Resource: "stacks", Action: "manage", Description: "Start, stop and deploy stacks"},
+	{Name: "files.read", Resource: "files", Action: "read", Description: "Read stack files"},
+	{Name: "files.write", Resource: "files", Action: "write", Description: "Write stack files"},
+	{Name: "logs.read", Resource: "logs", Action: "read", Description: "View container logs"},
+}
+
+type response struct {
+	status int
+	body   string
+}
+
+type FakeServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextID      int32
+	roles       map[int32]*Role
+	permissions []Permission
+	rules       map[int32][]Rule
+	servers     map[int32]*Server
+	stacks      map[int32][]Stack
+	files       map[int32]map[string]map[string]string
+	users       map[int32]*User
+	currentUser int32
+	lastHeaders http.Header
+	requests    map[string]int
+	disabled    map[string]bool
+	overrides   map[string]response
+	offline     map[string]int
+
+	omitCreatedRuleID bool
+}
+
+func NewServer() *FakeServer {
+	f := newFakeServer()
+	f.Server = httptest.NewServer(f.handler())
+	return f
+}
+
+func NewTLSServer() *FakeServer {
+	f := newFakeServer()
+	f.Server = httptest.NewTLSServer(f.handler())
+	return f
+}
+
+func newFakeServer() *FakeServer {
+	f := &FakeServer{
+		roles:     make(map[int32]*Role),
+		rules:     make(map[int32][]Rule),
+		servers:   make(map[int32]*Server),
+		stacks:    make(map[int32][]Stack),
+		files:     make(map[int32]map[string]map[string]string),
+		users:     make(map[int32]*User),
+		requests:  make(map[string]int),
+		disabled:  make(map[string]bool),
+		overrides: make(map[string]response),
+		offline:   make(map[string]int),
+	}
+
+	for _, p := range DefaultPermissions {
+		p.ID = f.id()
+		f.permissions = append(f.permissions, p)
+	}
+
+	return f
+}
+
+func (f *FakeServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/admin/permissions", f.listPermissions)
+	mux.HandleFunc("GET /api/v1/admin/roles", f.listRoles)
+	mux.HandleFunc("POST /api/v1/admin/roles", f.createRole)
+	mux.HandleFunc("PUT /api/v1/admin/roles/{id}", f.updateRole)
+	mux.HandleFunc("DELETE /api/v1/admin/roles/{id}", f.deleteRole)
+	mux.HandleFunc("GET /api/v1/admin/roles/{id}/stack-permissions", f.listRules)
+	mux.HandleFunc("POST /api/v1/admin/roles/{id}/stack-permissions", f.createRule)
+	mux.HandleFunc("DELETE /api/v1/admin/roles/{id}/stack-permissions/{ruleID}", f.deleteRule)
+	mux.HandleFunc("GET /api/v1/admin/servers", f.listServers)
+	mux.HandleFunc("GET /api/v1/servers", f.listServers)
+	mux.HandleFunc("POST /api/v1/admin/servers", f.createServer)
+	mux.HandleFunc("PUT /api/v1/admin/servers/{id}", f.updateServer)
+	mux.HandleFunc("DELETE /api/v1/admin/servers/{id}", f.deleteServer)
+	mux.HandleFunc("POST /api/v1/admin/servers/{id}/test", f.testServer)
+	mux.HandleFunc("GET /api/v1/admin/users", f.listUsers)
+	mux.HandleFunc("POST /api/v1/admin/users", f.createUser)
+	mux.HandleFunc("GET /api/v1/admin/users/{id}/roles", f.getUser)
+	mux.HandleFunc("POST /api/v1/admin/users/assign-role", f.assignRole)
+	mux.HandleFunc("POST /api/v1/admin/users/revoke-role", f.revokeRole)
+	mux.HandleFunc("GET /api/v1/profile", f.getProfile)
+	mux.HandleFunc("GET /api/v1/servers/{id}/stacks", f.listStacks)
+	mux.HandleFunc("POST /api/v1/servers/{id}/stacks", f.createStack)
+	mux.HandleFunc("GET /api/v1/servers/{id}/stacks/{name}", f.getStack)
+	mux.HandleFunc("GET /api/v1/servers/{id}/stacks/{name}/compose", f.getCompose)
+	mux.HandleFunc("GET /api/v1/servers/{id}/stacks/{name}/files/read", f.readFile)
+	mux.HandleFunc("POST /api/v1/servers/{id}/stacks/{name}/files/write", f.writeFile)
+	mux.HandleFunc("DELETE /api/v1/servers/{id}/stacks/{name}/files/delete", f.deleteFile)
+	mux.HandleFunc("GET /api/v1/version", f.getVersion)
+
+	return f.authenticate(mux)
+}
+
+func (f *FakeServer) AddRole(name, description string) Role {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role := &Role{ID: f.id(), Name: name, Description: description}
+	f.roles[role.ID] = role
+	return *role
+}
+
+func (f *FakeServer) AddAdminRole(name string) Role {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role := &Role{ID: f.id(), Name: name, IsAdmin: true}
+	f.roles[role.ID] = role
+	return *role
+}
+
+func (f *FakeServer) RemoveRole(id int32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.roles, id)
+	delete(f.rules, id)
+}
+
+func (f *FakeServer) SetOmitCreatedRuleID(omit bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.omitCreatedRuleID = omit
+}
+
+func (f *FakeServer) RemovePermission(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.permissions = slices.DeleteFunc(f.permissions, func(p Permission) bool { return p.Name == name })
+}
+
+func (f *FakeServer) Roles() []Role {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	roles := make([]Role, 0, len(f.roles))
+	for _, r := range f.sortedRoles() {
+		roles = append(roles, *r)
+	}
+	return roles
+}
+
+func (f *FakeServer) AddRule(roleID, serverID int32, permissionName, stackPattern string) Rule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var permissionID int32
+	for _, p := range f.permissions {
+		if p.Name == permissionName {
+			permissionID = p.ID
+		}
+	}
+
+	rule := Rule{ID: f.id(), PermissionID: permissionID, ServerID: serverID, StackPattern: stackPattern, IsStackBased: true}
+	f.rules[roleID] = append(f.rules[roleID], rule)
+	return rule
+}
+
+func (f *FakeServer) RemoveRule(roleID, ruleID int32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.removeRule(roleID, ruleID)
+}
+
+func (f *FakeServer) Rules(roleID int32) []Rule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Rule{}, f.rules[roleID]...)
+}
+
+func (f *FakeServer) AddServer(name, host string, port int32) Server {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	server := &Server{ID: f.id(), Name: name, Host: host, Port: port, IsActive: true, CreatedAt: now, UpdatedAt: now}
+	f.servers[server.ID] = server
+	return *server
+}
+
+func (f *FakeServer) SetServerActive(id int32, active bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if server, ok := f.servers[id]; ok {
+		server.IsActive = active
+	}
+}
+
+func (f *FakeServer) SetAgentOfflineFor(host string, attempts int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.offline[host] = attempts
+}
+
+func (f *FakeServer) Servers() []Server {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	servers := make([]Server, 0, len(f.servers))
+	for _, s := range f.sortedServers() {
+		servers = append(servers, *s)
+	}
+	return servers
+}
+
+func (f *FakeServer) AddUser(username, email string) User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user := &User{ID: f.id(), Username: username, Email: email, CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+	f.users[user.ID] = user
+	return *user
+}
+
+func (f *FakeServer) RemoveUser(id int32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.users, id)
+}
+
+func (f *FakeServer) Users() []User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	users := make([]User, 0, len(f.users))
+	for _, u := range f.sortedUsers() {
+		users = append(users, *u)
+	}
+	return users
+}
+
+func (f *FakeServer) SetCurrentUser(id int32) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.currentUser = id
+}
+
+func (f *FakeServer) DisableEndpoint(method, path string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.disabled[method+" "+path] = true
+}
+
+func (f *FakeServer) SetResponse(method, path string, status int, body string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.overrides[method+" "+path] = response{status: status, body: body}
+}
+
+func (f *FakeServer) LastRequestHeaders() http.Header {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.lastHeaders.Clone()
+}
+
+func (f *FakeServer) RequestCount(method, path string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.requests[method+" "+path]
+}
+
+func (f *FakeServer) AddStack(serverID int32, stack Stack) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stacks[serverID] = append(f.stacks[serverID], stack)
+}
+
+func (f *FakeServer) SetStackFile(serverID int32, stackName, path, content string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.stackFiles(serverID, stackName)[path] = content
+}
+
+func (f *FakeServer) StackFile(serverID int32, stackName, path string) (string, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	content, ok := f.files[serverID][stackName][path]
+	return content, ok
+}
+
+func (f *FakeServer) RemoveStackFile(serverID int32, stackName, path string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.files[serverID][stackName], path)
+}
+
+func (f *FakeServer) id() int32 {
+	f.nextID++
+	return f.nextID
+}
+
+func (f *FakeServer) sortedRoles() []*Role {
+	roles := make([]*Role, 0, len(f.roles))
+	for _, r := range f.roles {
+		roles = append(roles, r)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].ID < roles[j].ID })
+	return roles
+}
+
+func (f *FakeServer) sortedServers() []*Server {
+	servers := make([]*Server, 0, len(f.servers))
+	for _, s := range f.servers {
+		servers = append(servers, s)
+	}
+	sort.Slice(servers, func(i, j int) bool { return servers[i].ID < servers[j].ID })
+	return servers
+}
+
+func (f *FakeServer) sortedUsers() []*User {
+	users := make([]*User, 0, len(f.users))
+	for _, u := range f.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+	return users
+}
+
+func (f *FakeServer) removeRule(roleID, ruleID int32) bool {
+	rules := f.rules[roleID]
+	for i, rule := range rules {
+		if rule.ID == ruleID {
+			f.rules[roleID] = append(rules[:i:i], rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FakeServer) authenticate(next *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, pattern := next.Handler(r)
+
+		f.mu.Lock()
+		f.lastHeaders = r.Header.Clone()
+		f.requests[r.Method+" "+r.URL.Path]++
+		disabled := f.disabled[r.Method+" "+r.URL.Path] || f.disabled[pattern]
+		override, overridden := f.overrides[r.Method+" "+r.URL.Path]
+		f.mu.Unlock()
+
+		if disabled {
+			http.NotFound(w, r)
+			return
+		}
+		if overridden {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(override.status)
+			io.WriteString(w, override.body)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+APIKey {
+			writeError(w, http.StatusUnauthorized, "invalid API key")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (f *FakeServer) listPermissions(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]any{"permissions": f.permissions})
+}
+
+func (f *FakeServer) listRoles(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	roles := make([]map[string]any, 0, len(f.roles))
+	for _, role := range f.sortedRoles() {
+		roles = append(roles, roleWithPermissions(role))
+	}
+	writeData(w, http.StatusOK, map[string]any{"roles": roles})
+}
+
+func (f *FakeServer) createRole(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, role := range f.roles {
+		if role.Name == req.Name {
+			writeError(w, http.StatusConflict, "role already exists")
+			return
+		}
+	}
+
+	role := &Role{ID: f.id(), Name: req.Name, Description: req.Description}
+	f.roles[role.ID] = role
+	writeData(w, http.StatusCreated, roleWithPermissions(role))
+}
+
+func (f *FakeServer) updateRole(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.role(w, r)
+	if !ok {
+		return
+	}
+	role.Name = req.Name
+	role.Description = req.Description
+	writeData(w, http.StatusOK, roleWithPermissions(role))
+}
+
+func (f *FakeServer) deleteRole(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.role(w, r)
+	if !ok {
+		return
+	}
+	delete(f.roles, role.ID)
+	delete(f.rules, role.ID)
+	writeData(w, http.StatusOK, map[string]any{"message": "Role deleted successfully"})
+}
+
+func (f *FakeServer) listRules(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.role(w, r)
+	if !ok {
+		return
+	}
+
+	servers := make([]map[string]any, 0, len(f.servers))
+	for _, s := range f.sortedServers() {
+		servers = append(servers, map[string]any{
+			"id":          s.ID,
+			"name":        s.Name,
+			"description": s.Description,
+			"host":        s.Host,
+			"port":        s.Port,
+			"is_active":   s.IsActive,
+		})
+	}
+
+	rules := f.rules[role.ID]
+	if rules == nil {
+		rules = []Rule{}
+	}
+
+	writeData(w, http.StatusOK, map[string]any{
+		"role":            role,
+		"permissionRules": rules,
+		"permissions":     f.permissions,
+		"servers":         servers,
+	})
+}
+
+func (f *FakeServer) createRule(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		PermissionID int32  `json:"permission_id"`
+		ServerID     int32  `json:"server_id"`
+		StackPattern string `json:"stack_pattern"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.role(w, r)
+	if !ok {
+		return
+	}
+
+	known := false
+	for _, p := range f.permissions {
+		if p.ID == req.PermissionID {
+			known = true
+		}
+	}
+	if !known {
+		writeError(w, http.StatusBadRequest, "invalid permission")
+		return
+	}
+	if _, ok := f.servers[req.ServerID]; !ok {
+		writeError(w, http.StatusBadRequest, "invalid server")
+		return
+	}
+
+	for _, rule := range f.rules[role.ID] {
+		if rule.PermissionID == req.PermissionID && rule.ServerID == req.ServerID && rule.StackPattern == req.StackPattern {
+			writeError(w, http.StatusConflict, "permission rule already exists")
+			return
+		}
+	}
+
+	rule := Rule{
+		ID:           f.id(),
+		PermissionID: req.PermissionID,
+		ServerID:     req.ServerID,
+		StackPattern: req.StackPattern,
+		IsStackBased: true,
+	}
+	f.rules[role.ID] = append(f.rules[role.ID], rule)
+
+	data := map[string]any{"message": "Permission created successfully"}
+	if !f.omitCreatedRuleID {
+		data["id"] = rule.ID
+	}
+	writeData(w, http.StatusCreated, data)
+}
+
+func (f *FakeServer) deleteRule(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	role, ok := f.role(w, r)
+	if !ok {
+		return
+	}
+
+	ruleID, err := strconv.ParseInt(r.PathValue("ruleID"), 10, 32)
+	if err != nil || !f.removeRule(role.ID, int32(ruleID)) {
+		writeError(w, http.StatusNotFound, "permission rule not found")
+		return
+	}
+	writeData(w, http.StatusOK, map[string]any{"message": "Permission deleted successfully"})
+}
+
+func (f *FakeServer) listServers(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	writeData(w, http.StatusOK, map[string]any{"servers": f.sortedServers()})
+}
+
+type serverRequest struct {
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	Host                string `json:"host"`
+	Port                int32  `json:"port"`
+	IsActive            bool   `json:"is_active"`
+	SkipSSLVerification *bool  `json:"skip_ssl_verification"`
+	AccessToken         string `json:"access_token"`
+}
+
+func (req serverRequest) apply(s *Server) {
+	s.Name = req.Name
+	s.Description = req.Description
+	s.Host = req.Host
+	s.Port = req.Port
+	s.IsActive = req.IsActive
+	s.SkipSSLVerification = req.SkipSSLVerification != nil && *req.SkipSSLVerification
+	s.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+}
+
+func (f *FakeServer) createServer(w http.ResponseWriter, r *http.Request) {
+	var req serverRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server := &Server{ID: f.id(), CreatedAt: time.Now().UTC().Format(time.RFC3339)}
+	req.apply(server)
+	f.servers[server.ID] = server
+	writeData(w, http.StatusCreated, map[string]any{"server": server})
+}
+
+func (f *FakeServer) updateServer(w http.ResponseWriter, r *http.Request) {
+	var req serverRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+	req.apply(server)
+	writeData(w, http.StatusOK, map[string]any{"server": server})
+}
+
+func (f *FakeServer) deleteServer(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+	delete(f.servers, server.ID)
+	delete(f.stacks, server.ID)
+	delete(f.files, server.ID)
+	writeData(w, http.StatusOK, map[string]any{"message": "Server deleted successfully"})
+}
+
+func (f *FakeServer) testServer(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+	if f.offline[server.Host] != 0 {
+		if f.offline[server.Host] > 0 {
+			f.offline[server.Host]--
+		}
+		writeError(w, http.StatusServiceUnavailable, "connection test failed: agent unreachable")
+		return
+	}
+	writeData(w, http.StatusOK, map[string]any{"message": "Connection successful"})
+}
+
+func (f *FakeServer) listUsers(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	users := make([]map[string]any, 0, len(f.users))
+	for _, user := range f.sortedUsers() {
+		users = append(users, f.userInfo(user))
+	}
+	writeData(w, http.StatusOK, map[string]any{"users": users})
+}
+
+func (f *FakeServer) createUser(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Username        string `json:"username"`
+		Email           string `json:"email"`
+		Password        string `json:"password"`
+		PasswordConfirm string `json:"password_confirm"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	if req.Password == "" || req.Password != req.PasswordConfirm {
+		writeError(w, http.StatusBadRequest, "passwords do not match")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, user := range f.users {
+		if user.Username == req.Username || user.Email == req.Email {
+			writeError(w, http.StatusConflict, "user already exists")
+			return
+		}
+	}
+
+	user := &User{
+		ID:        f.id(),
+		Username:  req.Username,
+		Email:     req.Email,
+		Password:  req.Password,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	f.users[user.ID] = user
+	writeData(w, http.StatusCreated, f.userInfo(user))
+}
+
+func (f *FakeServer) getUser(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.user(w, r)
+	if !ok {
+		return
+	}
+
+	roles := make([]Role, 0, len(f.roles))
+	for _, role := range f.sortedRoles() {
+		roles = append(roles, *role)
+	}
+	writeData(w, http.StatusOK, map[string]any{"user": f.userInfo(user), "all_roles": roles})
+}
+
+func (f *FakeServer) getVersion(w http.ResponseWriter, r *http.Request) {
+	writeData(w, http.StatusOK, map[string]string{"version": Version})
+}
+
+func (f *FakeServer) getProfile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.users[f.currentUser]
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	writeData(w, http.StatusOK, f.userInfo(user))
+}
+
+type roleAssignmentRequest struct {
+	UserID int32 `json:"user_id"`
+	RoleID int32 `json:"role_id"`
+}
+
+func (f *FakeServer) assignRole(w http.ResponseWriter, r *http.Request) {
+	var req roleAssignmentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.users[req.UserID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+	if _, ok := f.roles[req.RoleID]; !ok {
+		writeError(w, http.StatusNotFound, "role not found")
+		return
+	}
+
+	for _, id := range user.RoleIDs {
+		if id == req.RoleID {
+			writeError(w, http.StatusConflict, "role already assigned")
+			return
+		}
+	}
+
+	user.RoleIDs = append(user.RoleIDs, req.RoleID)
+	writeData(w, http.StatusOK, map[string]any{"message": "Role assigned successfully"})
+}
+
+func (f *FakeServer) revokeRole(w http.ResponseWriter, r *http.Request) {
+	var req roleAssignmentRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	user, ok := f.users[req.UserID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "user not found")
+		return
+	}
+
+	for i, id := range user.RoleIDs {
+		if id == req.RoleID {
+			user.RoleIDs = append(user.RoleIDs[:i:i], user.RoleIDs[i+1:]...)
+			writeData(w, http.StatusOK, map[string]any{"message": "Role revoked successfully"})
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "role not assigned")
+}
+
+func (f *FakeServer) listStacks(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+
+	stacks := make([]map[string]any, 0, len(f.stacks[server.ID]))
+	for _, stack := range f.stacks[server.ID] {
+		stacks = append(stacks, stackSummary(server, stack))
+	}
+	writeData(w, http.StatusOK, map[string]any{"stacks": stacks})
+}
+
+func (f *FakeServer) createStack(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name string `json:"name"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "stack name is required")
+		return
+	}
+	for _, stack := range f.stacks[server.ID] {
+		if stack.Name == req.Name {
+			writeError(w, http.StatusConflict, "stack already exists")
+			return
+		}
+	}
+
+	stack := Stack{Name: req.Name}
+	f.stacks[server.ID] = append(f.stacks[server.ID], stack)
+	f.stackFiles(server.ID, stack.Name)[composeFile] = "services: {}\n"
+	writeData(w, http.StatusCreated, map[string]any{"message": "Stack created successfully", "stack": stackSummary(server, stack)})
+}
+
+func (f *FakeServer) readFile(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, stack, ok := f.stack(w, r)
+	if !ok {
+		return
+	}
+
+	path := r.URL.Query().Get("filePath")
+	content, ok := f.files[server.ID][stack.Name][path]
+	if !ok {
+		writeError(w, http.StatusNotFound, "file not found")
+		return
+	}
+	writeData(w, http.StatusOK, map[string]any{"path": path, "content": content, "encoding": "utf-8", "size": len(content)})
+}
+
+func (f *FakeServer) writeFile(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path    string `json:"path"`
+		Content string `json:"content"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, stack, ok := f.stack(w, r)
+	if !ok {
+		return
+	}
+
+	f.stackFiles(server.ID, stack.Name)[req.Path] = req.Content
+	writeData(w, http.StatusOK, map[string]any{"message": "File written successfully"})
+}
+
+func (f *FakeServer) deleteFile(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path string `json:"path"`
+	}
+	if !decode(w, r, &req) {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, stack, ok := f.stack(w, r)
+	if !ok {
+		return
+	}
+
+	files := f.files[server.ID][stack.Name]
+	if _, ok := files[req.Path]; !ok {
+		writeError(w, http.StatusNotFound, "file not found")
+		return
+	}
+	delete(files, req.Path)
+	writeData(w, http.StatusOK, map[string]any{"message": "File deleted successfully"})
+}
+
+func (f *FakeServer) getStack(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+
+	for _, stack := range f.stacks[server.ID] {
+		if stack.Name != r.PathValue("name") {
+			continue
+		}
+
+		services := make([]map[string]any, 0, len(stack.Services))
+		for _, service := range stack.Services {
+			containers := service.Containers
+			if containers == nil {
+				containers = []Container{}
+			}
+			services = append(services, map[string]any{
+				"name":       service.Name,
+				"image":      service.Image,
+				"containers": containers,
+			})
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"name":         stack.Name,
+			"path":         "/opt/compose/" + stack.Name,
+			"compose_file": composeFile,
+			"server_id":    server.ID,
+			"server_name":  server.Name,
+			"services":     services,
+		})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "stack not found")
+}
+
+func (f *FakeServer) getCompose(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	server, ok := f.server(w, r)
+	if !ok {
+		return
+	}
+
+	for _, stack := range f.stacks[server.ID] {
+		if stack.Name != r.PathValue("name") {
+			continue
+		}
+
+		images := stack.Compose
+		if images == nil {
+			images = make(map[string]string, len(stack.Services))
+			for _, service := range stack.Services {
+				images[service.Name] = service.Image
+			}
+		}
+
+		services := make(map[string]map[string]any, len(images))
+		for name, image := range images {
+			services[name] = map[string]any{"image": image}
+		}
+
+		writeJSON(w, http.StatusOK, map[string]any{
+			"compose_file": composeFile,
+			"services":     services,
+		})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "stack not found")
+}
+
+func (f *FakeServer) role(w http.ResponseWriter, r *http.Request) (*Role, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err == nil {
+		if role, ok := f.roles[int32(id)]; ok {
+			return role, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "role not found")
+	return nil, false
+}
+
+func (f *FakeServer) server(w http.ResponseWriter, r *http.Request) (*Server, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err == nil {
+		if server, ok := f.servers[int32(id)]; ok {
+			return server, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "server not found")
+	return nil, false
+}
+
+func (f *FakeServer) stack(w http.ResponseWriter, r *http.Request) (*Server, *Stack, bool) {
+	server, ok := f.server(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+
+	for i, stack := range f.stacks[server.ID] {
+		if stack.Name == r.PathValue("name") {
+			return server, &f.stacks[server.ID][i], true
+		}
+	}
+	writeError(w, http.StatusNotFound, "stack not found")
+	return nil, nil, false
+}
+
+func (f *FakeServer) stackFiles(serverID int32, stackName string) map[string]string {
+	if f.files[serverID] == nil {
+		f.files[serverID] = make(map[string]map[string]string)
+	}
+	if f.files[serverID][stackName] == nil {
+		f.files[serverID][stackName] = make(map[string]string)
+	}
+	return f.files[serverID][stackName]
+}
+
+func stackSummary(server *Server, stack Stack) map[string]any {
+	var running, total int
+	for _, service := range stack.Services {
+		for _, c := range service.Containers {
+			total++
+			if c.State == "running" {
+				running++
+			}
+		}
+	}
+
+	return map[string]any{
+		"name":               stack.Name,
+		"path":               "/opt/compose/" + stack.Name,
+		"compose_file":       composeFile,
+		"server_id":          server.ID,
+		"server_name":        server.Name,
+		"is_healthy":         running == total,
+		"running_containers": running,
+		"total_containers":   total,
+	}
+}
+
+func (f *FakeServer) user(w http.ResponseWriter, r *http.Request) (*User, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 32)
+	if err == nil {
+		if user, ok := f.users[int32(id)]; ok {
+			return user, true
+		}
+	}
+	writeError(w, http.StatusNotFound, "user not found")
+	return nil, false
+}
+
+func (f *FakeServer) userInfo(user *User) map[string]any {
+	roles := make([]Role, 0, len(user.RoleIDs))
+	for _, id := range user.RoleIDs {
+		if role, ok := f.roles[id]; ok {
+			roles = append(roles, *role)
+		}
+	}
+
+	return map[string]any{
+		"id":           user.ID,
+		"username":     user.Username,
+		"email":        user.Email,
+		"totp_enabled": false,
+		"created_at":   user.CreatedAt,
+		"updated_at":   user.CreatedAt,
+		"roles":        roles,
+	}
+}
+
+func roleWithPermissions(role *Role) map[string]any {
+	return map[string]any{
+		"id":          role.ID,
+		"name":        role.Name,
+		"description": role.Description,
+		"is_admin":    role.IsAdmin,
+		"permissions": []Permission{},
+	}
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+func writeData(w http.ResponseWriter, status int, data any) {
+	writeJSON(w, status, map[string]any{"success": true, "data": data})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{"code": status, "error": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+)
+
+var _ BerthAPI = (*Client)(nil)
+
+type BerthAPI interface {
+	GetVersion(ctx context.Context) (string, error)
+	SupportsFeature(ctx context.Context, feature Feature) (bool, error)
+
+	ListServers(ctx context.Context) ([]Server, error)
+	GetServer(ctx context.Context, id uint) (*Server, error)
+	GetServerByName(ctx context.Context, name string) (*Server, error)
+	CreateServer(ctx context.Context, server Server, accessToken string) (*Server, error)
+	UpdateServer(ctx context.Context, id uint, server Server, accessToken string) (*Server, error)
+	DeleteServer(ctx context.Context, id uint) error
+	TestServerConnection(ctx context.Context, id uint) error
+
+	ListRoles(ctx context.Context) ([]Role, error)
+	GetRole(ctx context.Context, id uint) (*Role, error)
+	GetRoleByName(ctx context.Context, name string) (*Role, error)
+	CreateRole(ctx context.Context, name, description string) (*Role, error)
+	UpdateRole(ctx context.Context, id uint, name, description string) (*Role, error)
+	DeleteRole(ctx context.Context, id uint) error
+
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	GetPermissionByName(ctx context.Context, name string) (*Permission, error)
+	ListRolePermissions(ctx context.Context, roleID uint) ([]RolePermission, []Permission, error)
+	GetRolePermission(ctx context.Context, roleID, permissionID uint) (*RolePermission, error)
+	CreateRolePermission(ctx context.Context, roleID, serverID, permissionID uint, stackPattern string) (*RolePermission, error)
+	DeleteRolePermission(ctx context.Context, roleID, permissionID uint) error
+
+	ListStacks(ctx context.Context, serverID uint) ([]Stack, error)
+	CreateStack(ctx context.Context, serverID uint, stackName string) error
+	GetStack(ctx context.Context, serverID uint, stackName string) (*Stack, error)
+	GetStackServices(ctx context.Context, serverID uint, stackName string) ([]StackService, error)
+	GetStackStats(ctx context.Context, serverID uint, stackName string) ([]ContainerStats, error)
+	ListStackImages(ctx context.Context, serverID uint, stackName string) ([]ContainerImage, error)
+	ListStackNetworks(ctx context.Context, serverID uint, stackName string) ([]StackNetwork, error)
+	GetComposeServiceImages(ctx context.Context, serverID uint, stackName string) (map[string]string, error)
+	GetLatestStackScan(ctx context.Context, serverID uint, stackName string) (*ImageScan, error)
+	ListNetworks(ctx context.Context, serverID uint) ([]DockerNetwork, error)
+	ReadStackFile(ctx context.Context, serverID uint, stackName, filePath string) (string, error)
+	WriteStackFile(ctx context.Context, serverID uint, stackName, filePath, content string) error
+	DeleteStackFile(ctx context.Context, serverID uint, stackName, filePath string) error
+
+	ListUsers(ctx context.Context) ([]User, error)
+	CreateUser(ctx context.Context, username, email, password string) (*User, error)
+	GetUser(ctx context.Context, id uint) (*User, error)
+	GetCurrentUser(ctx context.Context) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	GetUserByUsername(ctx context.Context, username string) (*User, error)
+	AssignUserRole(ctx context.Context, userID, roleID uint) error
+	RevokeUserRole(ctx context.Context, userID, roleID uint) error
+
+	ListOperationLogs(ctx context.Context, filter OperationLogFilter) ([]OperationLog, error)
+	ListSecurityEvents(ctx context.Context, filter SecurityEventFilter) ([]SecurityEvent, error)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Feature string
+
+const (
+	FeatureUserManagement   Feature = "user management"
+	FeatureServerManagement Feature = "server management"
+	FeatureStackFiles       Feature = "stack file management"
+)
+
+func (c *Client) SupportsFeature(ctx context.Context, feature Feature) (bool, error) {
+	var err error
+	switch feature {
+	case FeatureUserManagement:
+		_, err = c.ListUsers(ctx)
+	case FeatureServerManagement:
+		_, err = c.ListServers(ctx)
+	case FeatureStackFiles:
+		_, err = c.ReadStackFile(ctx, 0, "_", "")
+	default:
+		return false, fmt.Errorf("unknown Berth feature %q", feature)
+	}
+
+	if err == nil {
+		return true, nil
+	}
+	if isMissingEndpoint(err) {
+		return false, nil
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode != http.StatusUnauthorized && apiErr.StatusCode < http.StatusInternalServerError {
+		return true, nil
+	}
+	return false, fmt.Errorf("failed to probe Berth %s API: %w", feature, err)
+}
+
+func isMissingEndpoint(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusMethodNotAllowed:
+		return true
+	case http.StatusNotFound:
+		return apiErr.Message == "" || strings.EqualFold(apiErr.Message, http.StatusText(http.StatusNotFound))
+	}
+	return false
+}
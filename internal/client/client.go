@@ -3,17 +3,29 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	berth "github.com/tech-arch1tect/berth-go-api-client"
 )
 
 type Client struct {
-	api    *berth.APIClient
-	ctx    context.Context
-	apiKey string
+	api         *berth.APIClient
+	apiKey      string
+	permissions atomic.Pointer[permissionIndex]
+}
+
+type permissionIndex struct {
+	all    []Permission
+	byName map[string]Permission
 }
 
 type Role struct {
@@ -38,36 +50,292 @@ type RolePermission struct {
 	StackPattern string `json:"stack_pattern"`
 }
 
-func NewClient(baseURL, apiKey string, insecureSkipVerify bool) *Client {
+type User struct {
+	ID          uint   `json:"id"`
+	Username    string `json:"username"`
+	Email       string `json:"email"`
+	TOTPEnabled bool   `json:"totp_enabled"`
+	LastLoginAt string `json:"last_login_at"`
+	CreatedAt   string `json:"created_at"`
+	Roles       []Role `json:"roles"`
+}
+
+type Server struct {
+	ID                  uint   `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	Host                string `json:"host"`
+	Port                uint   `json:"port"`
+	IsActive            bool   `json:"is_active"`
+	SkipSSLVerification bool   `json:"skip_ssl_verification"`
+}
+
+type Stack struct {
+	Name              string `json:"name"`
+	Path              string `json:"path"`
+	ComposeFile       string `json:"compose_file"`
+	ServerID          uint   `json:"server_id"`
+	ServerName        string `json:"server_name"`
+	IsHealthy         bool   `json:"is_healthy"`
+	RunningContainers uint   `json:"running_containers"`
+	TotalContainers   uint   `json:"total_containers"`
+}
+
+type StackService struct {
+	Name       string      `json:"name"`
+	Image      string      `json:"image"`
+	Ports      []string    `json:"ports"`
+	Containers []Container `json:"containers"`
+}
+
+type Container struct {
+	Name     string             `json:"name"`
+	Image    string             `json:"image"`
+	State    string             `json:"state"`
+	Health   string             `json:"health"`
+	Mounts   []ContainerMount   `json:"mounts"`
+	Networks []ContainerNetwork `json:"networks"`
+	Ports    []ContainerPort    `json:"ports"`
+}
+
+type ContainerMount struct {
+	Type        string `json:"type"`
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	ReadWrite   bool   `json:"rw"`
+}
+
+type ContainerNetwork struct {
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address"`
+	Gateway   string `json:"gateway"`
+}
+
+type ContainerPort struct {
+	Private  uint   `json:"private"`
+	Public   uint   `json:"public"`
+	Protocol string `json:"type"`
+}
+
+type ContainerStats struct {
+	Name            string  `json:"name"`
+	ServiceName     string  `json:"service_name"`
+	CPUPercent      float64 `json:"cpu_percent"`
+	MemoryUsage     int64   `json:"memory_usage"`
+	MemoryLimit     int64   `json:"memory_limit"`
+	MemoryPercent   float64 `json:"memory_percent"`
+	NetworkRxBytes  int64   `json:"network_rx_bytes"`
+	NetworkTxBytes  int64   `json:"network_tx_bytes"`
+	BlockReadBytes  int64   `json:"block_read_bytes"`
+	BlockWriteBytes int64   `json:"block_write_bytes"`
+}
+
+type ContainerImage struct {
+	ContainerName string `json:"container_name"`
+	ImageName     string `json:"image_name"`
+	ImageID       string `json:"image_id"`
+}
+
+type DockerNetwork struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Driver   string            `json:"driver"`
+	Scope    string            `json:"scope"`
+	Subnet   string            `json:"subnet"`
+	Internal bool              `json:"internal"`
+	Unused   bool              `json:"unused"`
+	Labels   map[string]string `json:"labels"`
+}
+
+type StackNetwork struct {
+	Name       string   `json:"name"`
+	Driver     string   `json:"driver"`
+	External   bool     `json:"external"`
+	Exists     bool     `json:"exists"`
+	Subnets    []string `json:"subnets"`
+	Containers []string `json:"containers"`
+}
+
+type ImageScan struct {
+	ID              uint                 `json:"id"`
+	Status          string               `json:"status"`
+	TotalImages     uint                 `json:"total_images"`
+	ScannedImages   uint                 `json:"scanned_images"`
+	StartedAt       string               `json:"started_at"`
+	CompletedAt     string               `json:"completed_at"`
+	Images          []string             `json:"images"`
+	Vulnerabilities []ImageVulnerability `json:"vulnerabilities"`
+}
+
+type ImageVulnerability struct {
+	ImageName        string  `json:"image_name"`
+	VulnerabilityID  string  `json:"vulnerability_id"`
+	Package          string  `json:"package"`
+	InstalledVersion string  `json:"installed_version"`
+	FixedVersion     string  `json:"fixed_version"`
+	Severity         string  `json:"severity"`
+	CVSS             float64 `json:"cvss"`
+}
+
+type OperationLog struct {
+	ID            uint   `json:"id"`
+	OperationID   string `json:"operation_id"`
+	ServerID      uint   `json:"server_id"`
+	ServerName    string `json:"server_name"`
+	StackName     string `json:"stack_name"`
+	Command       string `json:"command"`
+	UserName      string `json:"user_name"`
+	TriggerSource string `json:"trigger_source"`
+	Status        string `json:"status"`
+	Success       *bool  `json:"success"`
+	ExitCode      *int   `json:"exit_code"`
+	StartTime     string `json:"start_time"`
+	EndTime       string `json:"end_time"`
+	DurationMs    *int   `json:"duration_ms"`
+}
+
+type OperationLogFilter struct {
+	ServerID  uint
+	StackName string
+	Command   string
+	Status    string
+	Search    string
+	DaysBack  int
+	Limit     int
+}
+
+type SecurityEvent struct {
+	ID            uint   `json:"id"`
+	EventType     string `json:"event_type"`
+	EventCategory string `json:"event_category"`
+	Severity      string `json:"severity"`
+	Success       bool   `json:"success"`
+	ActorUsername string `json:"actor_username"`
+	ActorIP       string `json:"actor_ip"`
+	TargetType    string `json:"target_type"`
+	TargetName    string `json:"target_name"`
+	ServerID      *uint  `json:"server_id"`
+	StackName     string `json:"stack_name"`
+	FailureReason string `json:"failure_reason"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type SecurityEventFilter struct {
+	EventType     string
+	EventCategory string
+	Severity      string
+	Success       *bool
+	StartDate     string
+	EndDate       string
+	Search        string
+	Limit         int
+}
+
+const (
+	HTTP2Disabled = "disabled"
+	HTTP2Enabled  = "enabled"
+	HTTP2Required = "required"
+)
+
+type Config struct {
+	URL                     string
+	APIKey                  string
+	InsecureSkipVerify      bool
+	RootCAs                 *x509.CertPool
+	ProxyURL                *url.URL
+	MaxConcurrentOperations int
+	RateLimit               float64
+	RateLimitBurst          int
+	OAuth                   *OAuthConfig
+	UserAgent               string
+	Headers                 map[string]string
+	HTTP2                   string
+	TLSSessionResumption    bool
+	DisableKeepAlives       bool
+	MaxRetries              int
+	RetryWaitMin            time.Duration
+	RetryWaitMax            time.Duration
+}
+
+func NewClient(config Config) *Client {
 	cfg := berth.NewConfiguration()
 	cfg.Servers = berth.ServerConfigurations{
-		{URL: baseURL},
+		{URL: config.URL},
 	}
 	cfg.Debug = false
+	if config.UserAgent != "" {
+		cfg.UserAgent = config.UserAgent
+	}
+	for name, value := range config.Headers {
+		cfg.AddDefaultHeader(name, value)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		RootCAs:            config.RootCAs,
+	}
+	if config.TLSSessionResumption {
+		tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(0)
+	}
+
+	baseTransport := &http.Transport{
+		Proxy:             http.ProxyFromEnvironment,
+		TLSClientConfig:   tlsConfig,
+		DisableKeepAlives: config.DisableKeepAlives,
+	}
+	if config.ProxyURL != nil {
+		baseTransport.Proxy = http.ProxyURL(config.ProxyURL)
+	}
+
+	switch config.HTTP2 {
+	case HTTP2Enabled:
+		baseTransport.Protocols = new(http.Protocols)
+		baseTransport.Protocols.SetHTTP1(true)
+		baseTransport.Protocols.SetHTTP2(true)
+	case HTTP2Required:
+		baseTransport.Protocols = new(http.Protocols)
+		baseTransport.Protocols.SetHTTP2(true)
+	}
+
+	timeout := 30 * time.Second
+	var transport http.RoundTripper = newLoggingTransport(newTimeoutTransport(baseTransport, timeout))
+	if config.OAuth != nil {
+		transport = newOAuthTransport(transport, *config.OAuth, &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: baseTransport,
+		})
+	}
+	if config.MaxConcurrentOperations > 0 {
+		transport = newConcurrencyLimitTransport(transport, config.MaxConcurrentOperations)
+	}
+	if config.RateLimit > 0 {
+		transport = newRateLimitTransport(transport, config.RateLimit, max(config.RateLimitBurst, 1))
+	}
+
+	if config.MaxRetries > 0 {
+		transport = newRetryTransport(transport, config.MaxRetries, config.RetryWaitMin, config.RetryWaitMax)
+	}
+
 	cfg.HTTPClient = &http.Client{
-		Timeout: 30 * time.Second,
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecureSkipVerify,
-			},
-		},
+		Transport: transport,
 	}
 
 	apiClient := berth.NewAPIClient(cfg)
 
-	ctx := context.WithValue(context.Background(), berth.ContextAccessToken, apiKey)
-
 	return &Client{
 		api:    apiClient,
-		ctx:    ctx,
-		apiKey: apiKey,
+		apiKey: config.APIKey,
 	}
 }
 
-func (c *Client) ListRoles() ([]Role, error) {
-	resp, _, err := c.api.AdminAPI.ApiV1AdminRolesGet(c.ctx).Execute()
+func (c *Client) authContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, berth.ContextAccessToken, c.apiKey)
+}
+
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesGet(c.authContext(ctx)).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list roles: %w", err)
+		return nil, fmt.Errorf("failed to list roles: %w", apiError(httpResp, err))
 	}
 
 	roles := make([]Role, 0, len(resp.Data.Roles))
@@ -83,8 +351,8 @@ func (c *Client) ListRoles() ([]Role, error) {
 	return roles, nil
 }
 
-func (c *Client) GetRole(id uint) (*Role, error) {
-	roles, err := c.ListRoles()
+func (c *Client) GetRole(ctx context.Context, id uint) (*Role, error) {
+	roles, err := c.ListRoles(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,15 +363,30 @@ func (c *Client) GetRole(id uint) (*Role, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("role not found")
+	return nil, fmt.Errorf("role %w", ErrNotFound)
+}
+
+func (c *Client) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	roles, err := c.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range roles {
+		if role.Name == name {
+			return &role, nil
+		}
+	}
+
+	return nil, fmt.Errorf("role '%s' %w", name, ErrNotFound)
 }
 
-func (c *Client) CreateRole(name, description string) (*Role, error) {
+func (c *Client) CreateRole(ctx context.Context, name, description string) (*Role, error) {
 	req := berth.NewCreateRoleRequest(description, name)
 
-	resp, _, err := c.api.AdminAPI.ApiV1AdminRolesPost(c.ctx).CreateRoleRequest(*req).Execute()
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesPost(c.authContext(ctx)).CreateRoleRequest(*req).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to create role: %w", err)
+		return nil, fmt.Errorf("failed to create role: %w", apiError(httpResp, err))
 	}
 
 	return &Role{
@@ -114,12 +397,12 @@ func (c *Client) CreateRole(name, description string) (*Role, error) {
 	}, nil
 }
 
-func (c *Client) UpdateRole(id uint, name, description string) (*Role, error) {
+func (c *Client) UpdateRole(ctx context.Context, id uint, name, description string) (*Role, error) {
 	req := berth.NewUpdateRoleRequest(description, name)
 
-	resp, _, err := c.api.AdminAPI.ApiV1AdminRolesIdPut(c.ctx, int32(id)).UpdateRoleRequest(*req).Execute()
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesIdPut(c.authContext(ctx), int32(id)).UpdateRoleRequest(*req).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to update role: %w", err)
+		return nil, fmt.Errorf("failed to update role: %w", apiError(httpResp, err))
 	}
 
 	return &Role{
@@ -130,18 +413,18 @@ func (c *Client) UpdateRole(id uint, name, description string) (*Role, error) {
 	}, nil
 }
 
-func (c *Client) DeleteRole(id uint) error {
-	_, _, err := c.api.AdminAPI.ApiV1AdminRolesIdDelete(c.ctx, int32(id)).Execute()
+func (c *Client) DeleteRole(ctx context.Context, id uint) error {
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesIdDelete(c.authContext(ctx), int32(id)).Execute()
 	if err != nil {
-		return fmt.Errorf("failed to delete role: %w", err)
+		return fmt.Errorf("failed to delete role: %w", apiError(httpResp, err))
 	}
 	return nil
 }
 
-func (c *Client) ListRolePermissions(roleID uint) ([]RolePermission, []Permission, error) {
-	resp, _, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsGet(c.ctx, int32(roleID)).Execute()
+func (c *Client) ListRolePermissions(ctx context.Context, roleID uint) ([]RolePermission, []Permission, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsGet(c.authContext(ctx), int32(roleID)).Execute()
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to list role permissions: %w", err)
+		return nil, nil, fmt.Errorf("failed to list role permissions: %w", apiError(httpResp, err))
 	}
 
 	perms := make([]RolePermission, 0, len(resp.Data.PermissionRules))
@@ -168,8 +451,8 @@ func (c *Client) ListRolePermissions(roleID uint) ([]RolePermission, []Permissio
 	return perms, permissions, nil
 }
 
-func (c *Client) GetRolePermission(roleID, permissionID uint) (*RolePermission, error) {
-	perms, _, err := c.ListRolePermissions(roleID)
+func (c *Client) GetRolePermission(ctx context.Context, roleID, permissionID uint) (*RolePermission, error) {
+	perms, _, err := c.ListRolePermissions(ctx, roleID)
 	if err != nil {
 		return nil, err
 	}
@@ -180,36 +463,76 @@ func (c *Client) GetRolePermission(roleID, permissionID uint) (*RolePermission,
 		}
 	}
 
-	return nil, fmt.Errorf("permission not found")
+	return nil, fmt.Errorf("permission %w", ErrNotFound)
 }
 
-func (c *Client) CreateRolePermission(roleID, serverID, permissionID uint, stackPattern string) (*RolePermission, error) {
+func (c *Client) CreateRolePermission(ctx context.Context, roleID, serverID, permissionID uint, stackPattern string) (*RolePermission, error) {
 	req := berth.NewCreateStackPermissionRequest(int32(permissionID), int32(serverID), stackPattern)
 
-	_, _, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsPost(c.ctx, int32(roleID)).CreateStackPermissionRequest(*req).Execute()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create role permission: %w", err)
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsPost(c.authContext(ctx), int32(roleID)).CreateStackPermissionRequest(*req).Execute()
+	var openAPIErr *berth.GenericOpenAPIError
+	if err != nil && (httpResp == nil || httpResp.StatusCode >= http.StatusMultipleChoices || !errors.As(err, &openAPIErr)) {
+		return nil, fmt.Errorf("failed to create role permission: %w", apiError(httpResp, err))
+	}
+
+	var body struct {
+		Success bool `json:"success"`
+		Data    struct {
+			ID uint `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode created role permission: %w", err)
+	}
+	if !body.Success {
+		return nil, fmt.Errorf("failed to create role permission: Berth reported an unsuccessful response with status %d", httpResp.StatusCode)
 	}
 
-	return &RolePermission{
+	created := &RolePermission{
+		ID:           body.Data.ID,
 		ServerID:     serverID,
 		PermissionID: permissionID,
 		StackPattern: stackPattern,
-	}, nil
+	}
+	if created.ID != 0 {
+		return created, nil
+	}
+
+	perms, _, err := c.ListRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read created role permission: %w", err)
+	}
+
+	var matches []uint
+	for _, p := range perms {
+		if p.ServerID == serverID && p.PermissionID == permissionID && p.StackPattern == stackPattern {
+			matches = append(matches, p.ID)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("failed to read created role permission: rule for permission %d on server %d with pattern '%s' %w", permissionID, serverID, stackPattern, ErrNotFound)
+	case 1:
+		created.ID = matches[0]
+	default:
+		return nil, fmt.Errorf("failed to read created role permission: %d rules match permission %d on server %d with pattern '%s': %v", len(matches), permissionID, serverID, stackPattern, matches)
+	}
+
+	return created, nil
 }
 
-func (c *Client) DeleteRolePermission(roleID, permissionID uint) error {
-	_, _, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsPermissionIdDelete(c.ctx, int32(roleID), int32(permissionID)).Execute()
+func (c *Client) DeleteRolePermission(ctx context.Context, roleID, permissionID uint) error {
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminRolesRoleIdStackPermissionsPermissionIdDelete(c.authContext(ctx), int32(roleID), int32(permissionID)).Execute()
 	if err != nil {
-		return fmt.Errorf("failed to delete role permission: %w", err)
+		return fmt.Errorf("failed to delete role permission: %w", apiError(httpResp, err))
 	}
 	return nil
 }
 
-func (c *Client) ListPermissions() ([]Permission, error) {
-	resp, _, err := c.api.AdminAPI.ApiV1AdminPermissionsGet(c.ctx).Execute()
+func (c *Client) ListPermissions(ctx context.Context) ([]Permission, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminPermissionsGet(c.authContext(ctx)).Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list permissions: %w", err)
+		return nil, fmt.Errorf("failed to list permissions: %w", apiError(httpResp, err))
 	}
 
 	permissions := make([]Permission, 0, len(resp.Data.Permissions))
@@ -223,20 +546,694 @@ func (c *Client) ListPermissions() ([]Permission, error) {
 		})
 	}
 
+	index := &permissionIndex{
+		all:    permissions,
+		byName: make(map[string]Permission, len(permissions)),
+	}
+	for _, p := range permissions {
+		index.byName[p.Name] = p
+	}
+	c.permissions.Store(index)
+
 	return permissions, nil
 }
 
-func (c *Client) GetPermissionByName(name string) (*Permission, error) {
-	permissions, err := c.ListPermissions()
+func (c *Client) GetPermissionByName(ctx context.Context, name string) (*Permission, error) {
+	index := c.permissions.Load()
+	if index == nil {
+		if _, err := c.ListPermissions(ctx); err != nil {
+			return nil, err
+		}
+		index = c.permissions.Load()
+	}
+
+	if perm, ok := index.byName[name]; ok {
+		return &perm, nil
+	}
+
+	return nil, UnknownPermissionError(name, index.all)
+}
+
+func (c *Client) ReadStackFile(ctx context.Context, serverID uint, stackName, filePath string) (string, error) {
+	resp, httpResp, err := c.api.FilesAPI.ApiV1ServersServeridStacksStacknameFilesReadGet(c.authContext(ctx), int32(serverID), stackName).FilePath(filePath).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to read stack file: %w", apiError(httpResp, err))
+	}
+
+	if resp.Data.Encoding == "base64" {
+		content, err := base64.StdEncoding.DecodeString(resp.Data.Content)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode stack file: %w", err)
+		}
+		return string(content), nil
+	}
+
+	return resp.Data.Content, nil
+}
+
+func (c *Client) WriteStackFile(ctx context.Context, serverID uint, stackName, filePath, content string) error {
+	req := berth.NewWriteFileRequest(content, filePath)
+
+	_, httpResp, err := c.api.FilesAPI.ApiV1ServersServeridStacksStacknameFilesWritePost(c.authContext(ctx), int32(serverID), stackName).WriteFileRequest(*req).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to write stack file: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) DeleteStackFile(ctx context.Context, serverID uint, stackName, filePath string) error {
+	req := berth.NewDeleteRequest2(filePath)
+
+	_, httpResp, err := c.api.FilesAPI.ApiV1ServersServeridStacksStacknameFilesDeleteDelete(c.authContext(ctx), int32(serverID), stackName).DeleteRequest2(*req).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to delete stack file: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) GetStackServices(ctx context.Context, serverID uint, stackName string) ([]StackService, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksStacknameGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stack: %w", apiError(httpResp, err))
+	}
+
+	services := make([]StackService, 0, len(resp.Services))
+	for _, s := range resp.Services {
+		containers := make([]Container, 0, len(s.Containers))
+		for _, ct := range s.Containers {
+			container := Container{
+				Name:   ct.Name,
+				Image:  ct.Image,
+				State:  ct.State,
+				Health: ct.GetHealth().Status,
+			}
+			for _, m := range ct.GetMounts() {
+				container.Mounts = append(container.Mounts, ContainerMount{
+					Type:        m.Type,
+					Source:      m.Source,
+					Destination: m.Destination,
+					ReadWrite:   m.Rw,
+				})
+			}
+			for _, n := range ct.GetNetworks() {
+				container.Networks = append(container.Networks, ContainerNetwork{
+					Name:      n.Name,
+					IPAddress: n.GetIpAddress(),
+					Gateway:   n.GetGateway(),
+				})
+			}
+			for _, p := range ct.GetPorts() {
+				container.Ports = append(container.Ports, ContainerPort{
+					Private:  uint(p.Private),
+					Public:   uint(p.GetPublic()),
+					Protocol: p.Type,
+				})
+			}
+			containers = append(containers, container)
+		}
+
+		services = append(services, StackService{
+			Name:       s.Name,
+			Image:      s.GetImage(),
+			Ports:      s.GetPorts(),
+			Containers: containers,
+		})
+	}
+
+	return services, nil
+}
+
+func (c *Client) ListStackImages(ctx context.Context, serverID uint, stackName string) ([]ContainerImage, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksStacknameImagesGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stack images: %w", apiError(httpResp, err))
+	}
+
+	images := make([]ContainerImage, 0, len(resp.Data.Images))
+	for _, img := range resp.Data.Images {
+		images = append(images, ContainerImage{
+			ContainerName: img.ContainerName,
+			ImageName:     img.ImageName,
+			ImageID:       img.ImageId,
+		})
+	}
+
+	return images, nil
+}
+
+func (c *Client) ListOperationLogs(ctx context.Context, filter OperationLogFilter) ([]OperationLog, error) {
+	const pageSize = 100
+
+	logs := make([]OperationLog, 0)
+	for page := int32(1); ; page++ {
+		req := c.api.AdminAPI.ApiV1AdminOperationLogsGet(c.authContext(ctx)).Page(page).PageSize(pageSize)
+		if filter.ServerID != 0 {
+			req = req.ServerId(strconv.FormatUint(uint64(filter.ServerID), 10))
+		}
+		if filter.StackName != "" {
+			req = req.StackName(filter.StackName)
+		}
+		if filter.Command != "" {
+			req = req.Command(filter.Command)
+		}
+		if filter.Status != "" {
+			req = req.Status(filter.Status)
+		}
+		if filter.Search != "" {
+			req = req.Search(filter.Search)
+		}
+		if filter.DaysBack > 0 {
+			req = req.DaysBack(int32(filter.DaysBack))
+		}
+
+		resp, httpResp, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list operation logs: %w", apiError(httpResp, err))
+		}
+
+		for _, l := range resp.Data.Data {
+			entry := OperationLog{
+				ID:            uint(l.Id),
+				OperationID:   l.OperationId,
+				ServerID:      uint(l.ServerId),
+				ServerName:    l.ServerName,
+				StackName:     l.StackName,
+				Command:       l.Command,
+				UserName:      l.UserName,
+				TriggerSource: l.TriggerSource,
+				Status:        l.GetStatus(),
+			}
+			if v, ok := l.GetSuccessOk(); ok && v != nil {
+				entry.Success = v
+			}
+			if v, ok := l.GetExitCodeOk(); ok && v != nil {
+				exitCode := int(*v)
+				entry.ExitCode = &exitCode
+			}
+			if v, ok := l.GetDurationMsOk(); ok && v != nil {
+				duration := int(*v)
+				entry.DurationMs = &duration
+			}
+			if v, ok := l.GetStartTimeOk(); ok && v != nil {
+				entry.StartTime = v.Format(time.RFC3339)
+			}
+			if v, ok := l.GetEndTimeOk(); ok && v != nil {
+				entry.EndTime = v.Format(time.RFC3339)
+			}
+
+			logs = append(logs, entry)
+			if filter.Limit > 0 && len(logs) >= filter.Limit {
+				return logs, nil
+			}
+		}
+
+		if !resp.Data.Pagination.HasNext {
+			break
+		}
+	}
+
+	return logs, nil
+}
+
+func (c *Client) GetVersion(ctx context.Context) (string, error) {
+	resp, httpResp, err := c.api.SystemAPI.ApiV1VersionGet(c.authContext(ctx)).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to get version: %w", apiError(httpResp, err))
+	}
+	return resp.Data.Version, nil
+}
+
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminUsersGet(c.authContext(ctx)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", apiError(httpResp, err))
+	}
+
+	users := make([]User, 0, len(resp.Data.Users))
+	for _, u := range resp.Data.Users {
+		users = append(users, userFromInfo(u))
+	}
+
+	return users, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, id uint) (*User, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminUsersIdRolesGet(c.authContext(ctx), int32(id)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", apiError(httpResp, err))
+	}
+
+	user := userFromInfo(resp.Data.User)
+	return &user, nil
+}
+
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
+	resp, httpResp, err := c.api.ProfileAPI.ApiV1ProfileGet(c.authContext(ctx)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get profile: %w", apiError(httpResp, err))
+	}
+
+	user := userFromInfo(resp.Data)
+	return &user, nil
+}
+
+func (c *Client) CreateUser(ctx context.Context, username, email, password string) (*User, error) {
+	req := berth.NewCreateUserRequest(email, password, password, username)
+
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminUsersPost(c.authContext(ctx)).CreateUserRequest(*req).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", apiError(httpResp, err))
+	}
+
+	user := userFromInfo(resp.Data)
+	return &user, nil
+}
+
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	users, err := c.ListUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, perm := range permissions {
-		if perm.Name == name {
-			return &perm, nil
+	for _, user := range users {
+		if user.Email == email {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user with email '%s' %w", email, ErrNotFound)
+}
+
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	users, err := c.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("user '%s' %w", username, ErrNotFound)
+}
+
+func (c *Client) AssignUserRole(ctx context.Context, userID, roleID uint) error {
+	req := berth.NewAssignRoleRequest(int32(roleID), int32(userID))
+
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminUsersAssignRolePost(c.authContext(ctx)).AssignRoleRequest(*req).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to assign role: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) RevokeUserRole(ctx context.Context, userID, roleID uint) error {
+	req := berth.NewRevokeRoleRequest(int32(roleID), int32(userID))
+
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminUsersRevokeRolePost(c.authContext(ctx)).RevokeRoleRequest(*req).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to revoke role: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) ListServers(ctx context.Context) ([]Server, error) {
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminServersGet(c.authContext(ctx)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list servers: %w", apiError(httpResp, err))
+	}
+
+	servers := make([]Server, 0, len(resp.Data.Servers))
+	for _, s := range resp.Data.Servers {
+		servers = append(servers, serverFromInfo(s))
+	}
+
+	return servers, nil
+}
+
+func (c *Client) GetServer(ctx context.Context, id uint) (*Server, error) {
+	servers, err := c.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, server := range servers {
+		if server.ID == id {
+			return &server, nil
+		}
+	}
+
+	return nil, fmt.Errorf("server %w", ErrNotFound)
+}
+
+func (c *Client) GetServerByName(ctx context.Context, name string) (*Server, error) {
+	servers, err := c.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, server := range servers {
+		if server.Name == name {
+			return &server, nil
 		}
 	}
 
-	return nil, fmt.Errorf("permission '%s' not found", name)
+	return nil, fmt.Errorf("server '%s' %w", name, ErrNotFound)
+}
+
+func (c *Client) CreateServer(ctx context.Context, server Server, accessToken string) (*Server, error) {
+	req := berth.NewServerCreateRequest(
+		accessToken,
+		server.Description,
+		server.Host,
+		server.IsActive,
+		server.Name,
+		int32(server.Port),
+		*berth.NewNullableBool(&server.SkipSSLVerification),
+	)
+
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminServersPost(c.authContext(ctx)).ServerCreateRequest(*req).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create server: %w", apiError(httpResp, err))
+	}
+
+	created := serverFromInfo(resp.Data.Server)
+	return &created, nil
+}
+
+func (c *Client) UpdateServer(ctx context.Context, id uint, server Server, accessToken string) (*Server, error) {
+	req := berth.NewServerUpdateRequest(
+		accessToken,
+		server.Description,
+		server.Host,
+		server.IsActive,
+		server.Name,
+		int32(server.Port),
+		*berth.NewNullableBool(&server.SkipSSLVerification),
+	)
+
+	resp, httpResp, err := c.api.AdminAPI.ApiV1AdminServersIdPut(c.authContext(ctx), int32(id)).ServerUpdateRequest(*req).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to update server: %w", apiError(httpResp, err))
+	}
+
+	updated := serverFromInfo(resp.Data.Server)
+	return &updated, nil
+}
+
+func (c *Client) DeleteServer(ctx context.Context, id uint) error {
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminServersIdDelete(c.authContext(ctx), int32(id)).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to delete server: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) TestServerConnection(ctx context.Context, id uint) error {
+	_, httpResp, err := c.api.AdminAPI.ApiV1AdminServersIdTestPost(c.authContext(ctx), int32(id)).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to test server connection: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func serverFromInfo(s berth.ServerInfo) Server {
+	return Server{
+		ID:                  uint(s.Id),
+		Name:                s.Name,
+		Description:         s.Description,
+		Host:                s.Host,
+		Port:                uint(s.Port),
+		IsActive:            s.IsActive,
+		SkipSSLVerification: s.SkipSslVerification,
+	}
+}
+
+func userFromInfo(u berth.UserInfo) User {
+	roles := make([]Role, 0, len(u.GetRoles()))
+	for _, r := range u.GetRoles() {
+		roles = append(roles, Role{
+			ID:          uint(r.Id),
+			Name:        r.Name,
+			Description: r.Description,
+			IsAdmin:     r.IsAdmin,
+		})
+	}
+
+	return User{
+		ID:          uint(u.Id),
+		Username:    u.Username,
+		Email:       u.Email,
+		TOTPEnabled: u.TotpEnabled,
+		LastLoginAt: u.GetLastLoginAt(),
+		CreatedAt:   u.CreatedAt,
+		Roles:       roles,
+	}
+}
+
+func (c *Client) ListSecurityEvents(ctx context.Context, filter SecurityEventFilter) ([]SecurityEvent, error) {
+	const perPage = 100
+
+	events := make([]SecurityEvent, 0)
+	for page := int32(1); ; page++ {
+		req := c.api.AdminAPI.ApiV1AdminSecurityAuditLogsGet(c.authContext(ctx)).Page(page).PerPage(perPage)
+		if filter.EventType != "" {
+			req = req.EventType(filter.EventType)
+		}
+		if filter.EventCategory != "" {
+			req = req.EventCategory(filter.EventCategory)
+		}
+		if filter.Severity != "" {
+			req = req.Severity(filter.Severity)
+		}
+		if filter.Success != nil {
+			req = req.Success(strconv.FormatBool(*filter.Success))
+		}
+		if filter.StartDate != "" {
+			req = req.StartDate(filter.StartDate)
+		}
+		if filter.EndDate != "" {
+			req = req.EndDate(filter.EndDate)
+		}
+		if filter.Search != "" {
+			req = req.Search(filter.Search)
+		}
+
+		resp, httpResp, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list security events: %w", apiError(httpResp, err))
+		}
+
+		for _, l := range resp.Data.Logs {
+			event := SecurityEvent{
+				ID:            uint(l.Id),
+				EventType:     l.EventType,
+				EventCategory: l.EventCategory,
+				Severity:      l.Severity,
+				Success:       l.Success,
+				ActorUsername: l.ActorUsername,
+				ActorIP:       l.ActorIp,
+				TargetType:    l.TargetType,
+				TargetName:    l.TargetName,
+				StackName:     l.StackName,
+				FailureReason: l.FailureReason,
+				CreatedAt:     l.CreatedAt.Format(time.RFC3339),
+			}
+			if v := l.ServerId.Get(); v != nil {
+				serverID := uint(*v)
+				event.ServerID = &serverID
+			}
+
+			events = append(events, event)
+			if filter.Limit > 0 && len(events) >= filter.Limit {
+				return events, nil
+			}
+		}
+
+		if page >= resp.Data.TotalPages {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+func (c *Client) ListStacks(ctx context.Context, serverID uint) ([]Stack, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksGet(c.authContext(ctx), int32(serverID)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stacks: %w", apiError(httpResp, err))
+	}
+
+	stacks := make([]Stack, 0, len(resp.Data.Stacks))
+	for _, s := range resp.Data.Stacks {
+		stacks = append(stacks, Stack{
+			Name:              s.Name,
+			Path:              s.Path,
+			ComposeFile:       s.ComposeFile,
+			ServerID:          uint(s.ServerId),
+			ServerName:        s.ServerName,
+			IsHealthy:         s.IsHealthy,
+			RunningContainers: uint(s.RunningContainers),
+			TotalContainers:   uint(s.TotalContainers),
+		})
+	}
+
+	return stacks, nil
+}
+
+func (c *Client) GetStack(ctx context.Context, serverID uint, stackName string) (*Stack, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksStacknameGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stack: %w", apiError(httpResp, err))
+	}
+
+	return &Stack{
+		Name:        resp.Name,
+		Path:        resp.Path,
+		ComposeFile: resp.ComposeFile,
+		ServerID:    uint(resp.ServerId),
+		ServerName:  resp.ServerName,
+	}, nil
+}
+
+func (c *Client) CreateStack(ctx context.Context, serverID uint, stackName string) error {
+	req := berth.NewCreateStackRequest(stackName)
+
+	_, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksPost(c.authContext(ctx), int32(serverID)).CreateStackRequest(*req).Execute()
+	if err != nil {
+		return fmt.Errorf("failed to create stack: %w", apiError(httpResp, err))
+	}
+	return nil
+}
+
+func (c *Client) GetStackStats(ctx context.Context, serverID uint, stackName string) ([]ContainerStats, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksStacknameStatsGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stack stats: %w", apiError(httpResp, err))
+	}
+
+	stats := make([]ContainerStats, 0, len(resp.Data.Containers))
+	for _, s := range resp.Data.Containers {
+		stats = append(stats, ContainerStats{
+			Name:            s.Name,
+			ServiceName:     s.ServiceName,
+			CPUPercent:      float64(s.CpuPercent),
+			MemoryUsage:     int64(s.MemoryUsage),
+			MemoryLimit:     int64(s.MemoryLimit),
+			MemoryPercent:   float64(s.MemoryPercent),
+			NetworkRxBytes:  int64(s.NetworkRxBytes),
+			NetworkTxBytes:  int64(s.NetworkTxBytes),
+			BlockReadBytes:  int64(s.BlockReadBytes),
+			BlockWriteBytes: int64(s.BlockWriteBytes),
+		})
+	}
+
+	return stats, nil
+}
+
+func (c *Client) ListNetworks(ctx context.Context, serverID uint) ([]DockerNetwork, error) {
+	resp, httpResp, err := c.api.MaintenanceAPI.ApiV1ServersServeridMaintenanceInfoGet(c.authContext(ctx), int32(serverID)).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %w", apiError(httpResp, err))
+	}
+
+	networks := make([]DockerNetwork, 0, len(resp.NetworkSummary.Networks))
+	for _, n := range resp.NetworkSummary.Networks {
+		networks = append(networks, DockerNetwork{
+			ID:       n.Id,
+			Name:     n.Name,
+			Driver:   n.Driver,
+			Scope:    n.Scope,
+			Subnet:   n.Subnet,
+			Internal: n.Internal,
+			Unused:   n.Unused,
+			Labels:   n.Labels,
+		})
+	}
+
+	return networks, nil
+}
+
+func (c *Client) ListStackNetworks(ctx context.Context, serverID uint, stackName string) ([]StackNetwork, error) {
+	resp, httpResp, err := c.api.StacksAPI.ApiV1ServersServeridStacksStacknameNetworksGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stack networks: %w", apiError(httpResp, err))
+	}
+
+	networks := make([]StackNetwork, 0, len(resp.Data.Networks))
+	for _, n := range resp.Data.Networks {
+		network := StackNetwork{
+			Name:       n.Name,
+			Driver:     n.GetDriver(),
+			External:   n.GetExternal(),
+			Exists:     n.Exists,
+			Subnets:    []string{},
+			Containers: []string{},
+		}
+		if ipam, ok := n.GetIpamOk(); ok && ipam != nil {
+			for _, cfg := range ipam.GetConfig() {
+				if cfg.GetSubnet() != "" {
+					network.Subnets = append(network.Subnets, cfg.GetSubnet())
+				}
+			}
+		}
+		for _, endpoint := range n.GetContainers() {
+			network.Containers = append(network.Containers, endpoint.Name)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+func (c *Client) GetComposeServiceImages(ctx context.Context, serverID uint, stackName string) (map[string]string, error) {
+	resp, httpResp, err := c.api.ComposeAPI.ApiV1ServersServeridStacksStacknameComposeGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compose configuration: %w", apiError(httpResp, err))
+	}
+
+	images := make(map[string]string, len(resp.Services))
+	for name, service := range resp.Services {
+		image, _ := service["image"].(string)
+		images[name] = image
+	}
+
+	return images, nil
+}
+
+func (c *Client) GetLatestStackScan(ctx context.Context, serverID uint, stackName string) (*ImageScan, error) {
+	resp, httpResp, err := c.api.VulnscanAPI.ApiV1ServersServeridStacksStacknameVulnscanGet(c.authContext(ctx), int32(serverID), stackName).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest scan: %w", apiError(httpResp, err))
+	}
+
+	s := resp.Data.Scan
+	scan := &ImageScan{
+		ID:            uint(s.Id),
+		Status:        s.Status,
+		TotalImages:   uint(s.TotalImages),
+		ScannedImages: uint(s.ScannedImages),
+		StartedAt:     s.StartedAt.Format(time.RFC3339),
+	}
+	if completed, ok := s.GetCompletedAtOk(); ok && completed != nil {
+		scan.CompletedAt = completed.Format(time.RFC3339)
+	}
+	for _, scope := range s.GetScopes() {
+		scan.Images = append(scan.Images, scope.ImageName)
+	}
+	for _, v := range s.GetVulnerabilities() {
+		scan.Vulnerabilities = append(scan.Vulnerabilities, ImageVulnerability{
+			ImageName:        v.ImageName,
+			VulnerabilityID:  v.VulnerabilityId,
+			Package:          v.Package,
+			InstalledVersion: v.InstalledVersion,
+			FixedVersion:     v.GetFixedVersion(),
+			Severity:         v.Severity,
+			CVSS:             float64(v.GetCvss()),
+		})
+	}
+
+	return scan, nil
 }
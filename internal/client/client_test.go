@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func newTestClient(t *testing.T) (*berthtest.FakeServer, *Client) {
+	t.Helper()
+
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+
+	return fake, NewClient(Config{URL: fake.URL, APIKey: berthtest.APIKey})
+}
+
+func TestRoles(t *testing.T) {
+	_, c := newTestClient(t)
+
+	role, err := c.CreateRole(context.Background(), "operators", "Stack operators")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.UpdateRole(context.Background(), role.ID, "operators", "Updated"); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetRole(context.Background(), role.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Description != "Updated" {
+		t.Fatalf("expected updated description, got %q", got.Description)
+	}
+
+	if err := c.DeleteRole(context.Background(), role.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetRole(context.Background(), role.ID); !IsNotFound(err) {
+		t.Fatalf("expected not found error reading deleted role, got %v", err)
+	}
+	if err := c.DeleteRole(context.Background(), role.ID); !IsNotFound(err) {
+		t.Fatalf("expected not found error deleting deleted role, got %v", err)
+	}
+}
+
+func TestRolePermissions(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+
+	permission, err := c.GetPermissionByName(context.Background(), "stacks.manage")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateRolePermission(context.Background(), uint(role.ID), uint(server.ID), permission.ID, "prod-*"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateRolePermission(context.Background(), uint(role.ID), uint(server.ID), permission.ID, "prod-*"); err == nil {
+		t.Fatal("expected error creating duplicate rule")
+	}
+
+	perms, permissions, err := c.ListRolePermissions(context.Background(), uint(role.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(perms) != 1 || perms[0].PermissionID != permission.ID || perms[0].StackPattern != "prod-*" {
+		t.Fatalf("unexpected rules: %+v", perms)
+	}
+	if len(permissions) != len(berthtest.DefaultPermissions) {
+		t.Fatalf("expected %d permissions, got %d", len(berthtest.DefaultPermissions), len(permissions))
+	}
+
+	if err := c.DeleteRolePermission(context.Background(), uint(role.ID), perms[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetRolePermission(context.Background(), uint(role.ID), perms[0].ID); !IsNotFound(err) {
+		t.Fatalf("expected not found error reading deleted rule, got %v", err)
+	}
+
+	fake.RemoveRole(role.ID)
+	if _, _, err := c.ListRolePermissions(context.Background(), uint(role.ID)); !IsNotFound(err) {
+		t.Fatalf("expected not found error listing rules of deleted role, got %v", err)
+	}
+
+	if _, err := c.GetPermissionByName(context.Background(), "stacks.destroy"); err == nil {
+		t.Fatal("expected error for unknown permission")
+	}
+}
+
+func TestCreateRolePermissionID(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+
+	permission, err := c.GetPermissionByName(context.Background(), "stacks.read")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, omit := range map[string]bool{"from response": false, "from rule list": true} {
+		t.Run(name, func(t *testing.T) {
+			fake.SetOmitCreatedRuleID(omit)
+			pattern := strings.ReplaceAll(name, " ", "-")
+
+			created, err := c.CreateRolePermission(context.Background(), uint(role.ID), uint(server.ID), permission.ID, pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rules := fake.Rules(role.ID)
+			if want := uint(rules[len(rules)-1].ID); created.ID != want {
+				t.Fatalf("expected created rule ID %d, got %d", want, created.ID)
+			}
+		})
+	}
+}
+
+func TestStacks(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{
+		Name: "web",
+		Services: []berthtest.Service{
+			{Name: "app", Image: "nginx:1.27", Containers: []berthtest.Container{{Name: "web-app-1", Image: "nginx:1.27", State: "running"}}},
+			{Name: "worker", Image: "busybox", Containers: []berthtest.Container{{Name: "web-worker-1", Image: "busybox", State: "exited"}}},
+		},
+	})
+
+	servers, err := c.ListServers(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) != 1 || servers[0].Name != "prod" {
+		t.Fatalf("unexpected servers: %+v", servers)
+	}
+
+	stacks, err := c.ListStacks(context.Background(), uint(server.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stacks) != 1 || stacks[0].RunningContainers != 1 || stacks[0].TotalContainers != 2 {
+		t.Fatalf("unexpected stacks: %+v", stacks)
+	}
+
+	services, err := c.GetStackServices(context.Background(), uint(server.ID), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != 2 || services[1].Containers[0].State != "exited" {
+		t.Fatalf("unexpected services: %+v", services)
+	}
+}
+
+func TestInvalidAPIKey(t *testing.T) {
+	fake, _ := newTestClient(t)
+	c := NewClient(Config{URL: fake.URL, APIKey: "wrong"})
+
+	if _, err := c.ListRoles(context.Background()); err == nil {
+		t.Fatal("expected error with invalid API key")
+	}
+}
+
+func TestContextCancellation(t *testing.T) {
+	_, c := newTestClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.ListRoles(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestSupportsFeature(t *testing.T) {
+	tests := []struct {
+		feature Feature
+		disable string
+	}{
+		{feature: FeatureUserManagement, disable: "/api/v1/admin/users"},
+		{feature: FeatureServerManagement, disable: "/api/v1/admin/servers"},
+		{feature: FeatureStackFiles, disable: "/api/v1/servers/{id}/stacks/{name}/files/read"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.feature), func(t *testing.T) {
+			fake, c := newTestClient(t)
+
+			supported, err := c.SupportsFeature(context.Background(), tt.feature)
+			if err != nil || !supported {
+				t.Fatalf("expected %s to be supported, got %v, %v", tt.feature, supported, err)
+			}
+
+			fake.DisableEndpoint(http.MethodGet, tt.disable)
+			supported, err = c.SupportsFeature(context.Background(), tt.feature)
+			if err != nil || supported {
+				t.Fatalf("expected %s to be unsupported, got %v, %v", tt.feature, supported, err)
+			}
+		})
+	}
+}
+
+func TestSupportsFeature_Unauthorized(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	c := NewClient(Config{URL: fake.URL, APIKey: "wrong"})
+
+	if _, err := c.SupportsFeature(context.Background(), FeatureUserManagement); err == nil {
+		t.Fatal("expected probe to fail when the API key is rejected")
+	}
+}
+
+func TestGetPermissionByNameCachesCatalog(t *testing.T) {
+	fake, c := newTestClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"stacks.read", "stacks.manage", "logs.read"} {
+		perm, err := c.GetPermissionByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if perm.Name != name {
+			t.Fatalf("expected %s, got %+v", name, perm)
+		}
+	}
+
+	if _, err := c.GetPermissionByName(ctx, "stacks.raed"); err == nil || !strings.Contains(err.Error(), "stacks.read") {
+		t.Fatalf("expected unknown permission error with suggestion, got %v", err)
+	}
+
+	if count := fake.RequestCount(http.MethodGet, "/api/v1/admin/permissions"); count != 1 {
+		t.Fatalf("expected the permission catalog to be fetched once, got %d", count)
+	}
+}
+
+func TestCreateRolePermissionResponseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		rules   int
+		wantErr string
+	}{
+		{name: "malformed body", status: http.StatusCreated, body: "not json", wantErr: "failed to decode created role permission"},
+		{name: "unsuccessful envelope", status: http.StatusCreated, body: `{"success":false,"data":{}}`, wantErr: "unsuccessful response"},
+		{name: "ambiguous rule", status: http.StatusCreated, body: `{"success":true,"data":{"message":"created"}}`, rules: 2, wantErr: "2 rules match"},
+		{name: "missing rule", status: http.StatusCreated, body: `{"success":true,"data":{"message":"created"}}`, wantErr: "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, c := newTestClient(t)
+			server := fake.AddServer("prod", "10.0.0.1", 8081)
+			role := fake.AddRole("deployers", "")
+			for range tt.rules {
+				fake.AddRule(role.ID, server.ID, "stacks.read", "web")
+			}
+			fake.SetResponse(http.MethodPost, fmt.Sprintf("/api/v1/admin/roles/%d/stack-permissions", role.ID), tt.status, tt.body)
+
+			permission, err := c.GetPermissionByName(context.Background(), "stacks.read")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.CreateRolePermission(context.Background(), uint(role.ID), uint(server.ID), permission.ID, "web")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
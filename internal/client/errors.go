@@ -0,0 +1,129 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	berth "github.com/tech-arch1tect/berth-go-api-client"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type APIError struct {
+	StatusCode  int
+	Code        int
+	Message     string
+	FieldErrors map[string]string
+	Err         error
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
+	if len(e.FieldErrors) > 0 {
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field, fieldErr := range e.FieldErrors {
+			fields = append(fields, fmt.Sprintf("%s: %s", field, fieldErr))
+		}
+		sort.Strings(fields)
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(fields, "; "))
+	}
+
+	return fmt.Sprintf("API error (status %d): %s", e.StatusCode, message)
+}
+
+func (e *APIError) Unwrap() []error {
+	if e.StatusCode == http.StatusNotFound {
+		return []error{e.Err, ErrNotFound}
+	}
+	return []error{e.Err}
+}
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+func apiError(resp *http.Response, err error) error {
+	if resp == nil {
+		return err
+	}
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, Err: err}
+
+	var openAPIErr *berth.GenericOpenAPIError
+	if errors.As(err, &openAPIErr) {
+		var envelope struct {
+			Code    int             `json:"code"`
+			Error   string          `json:"error"`
+			Message string          `json:"message"`
+			Errors  json.RawMessage `json:"errors"`
+		}
+		if json.Unmarshal(openAPIErr.Body(), &envelope) == nil {
+			apiErr.Code = envelope.Code
+			apiErr.Message = envelope.Error
+			if apiErr.Message == "" {
+				apiErr.Message = envelope.Message
+			}
+
+			var fieldErrors map[string]string
+			if json.Unmarshal(envelope.Errors, &fieldErrors) == nil && len(fieldErrors) > 0 {
+				apiErr.FieldErrors = fieldErrors
+			}
+		}
+	}
+
+	return apiErr
+}
+
+func UnknownPermissionError(name string, permissions []Permission) error {
+	names := make([]string, 0, len(permissions))
+	for _, p := range permissions {
+		names = append(names, p.Name)
+	}
+
+	if suggestion := closestName(name, names); suggestion != "" {
+		return fmt.Errorf("permission '%s' %w, did you mean '%s'?", name, ErrNotFound, suggestion)
+	}
+	return fmt.Errorf("permission '%s' %w, valid permissions are: %s", name, ErrNotFound, strings.Join(names, ", "))
+}
+
+func closestName(name string, candidates []string) string {
+	best := ""
+	bestDistance := max(2, len(name)/3) + 1
+	for _, candidate := range candidates {
+		if distance := levenshtein(name, candidate); distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
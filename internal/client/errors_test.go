@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPIError_ParsesEnvelope(t *testing.T) {
+	_, c := newTestClient(t)
+
+	if _, err := c.CreateRole(context.Background(), "operators", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := c.CreateRole(context.Background(), "operators", "")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.StatusCode != http.StatusConflict || apiErr.Code != http.StatusConflict || apiErr.Message != "role already exists" {
+		t.Fatalf("unexpected API error: %+v", apiErr)
+	}
+	if !strings.Contains(err.Error(), "API error (status 409): role already exists") {
+		t.Fatalf("unexpected error message: %v", err)
+	}
+	if IsNotFound(err) {
+		t.Fatal("conflict should not be reported as not found")
+	}
+}
+
+func TestAPIError_FieldErrors(t *testing.T) {
+	err := &APIError{
+		StatusCode:  http.StatusUnprocessableEntity,
+		Message:     "validation failed",
+		FieldErrors: map[string]string{"stack_pattern": "is required", "name": "is too long"},
+	}
+
+	want := "API error (status 422): validation failed (name: is too long; stack_pattern: is required)"
+	if err.Error() != want {
+		t.Fatalf("expected %q, got %q", want, err.Error())
+	}
+}
+
+func TestUnknownPermissionError(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, err := c.GetPermissionByName(context.Background(), "stacks.raed")
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "did you mean 'stacks.read'?") {
+		t.Fatalf("expected a suggestion for a typo, got %v", err)
+	}
+
+	_, err = c.GetPermissionByName(context.Background(), "deploy")
+	if !IsNotFound(err) || !strings.Contains(err.Error(), "valid permissions are: stacks.read") {
+		t.Fatalf("expected the list of valid permissions, got %v", err)
+	}
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const oauthExpiryDelta = 30 * time.Second
+
+type OAuthConfig struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+}
+
+type oauthTransport struct {
+	base       http.RoundTripper
+	config     OAuthConfig
+	httpClient *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func newOAuthTransport(base http.RoundTripper, config OAuthConfig, httpClient *http.Client) *oauthTransport {
+	return &oauthTransport{
+		base:       base,
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.accessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		t.invalidate(token)
+	}
+	return resp, err
+}
+
+func (t *oauthTransport) accessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != "" && time.Now().Before(t.expiry) {
+		return t.token, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(t.config.Scopes) > 0 {
+		form.Set("scope", strings.Join(t.config.Scopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(t.config.ClientID), url.QueryEscape(t.config.ClientSecret))
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to request access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken      string `json:"access_token"`
+		TokenType        string `json:"token_type"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token response (status %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		message := body.Error
+		if body.ErrorDescription != "" {
+			message = fmt.Sprintf("%s: %s", message, body.ErrorDescription)
+		}
+		return "", fmt.Errorf("failed to request access token (status %d): %s", resp.StatusCode, message)
+	}
+
+	if body.TokenType != "" && !strings.EqualFold(body.TokenType, "bearer") {
+		return "", fmt.Errorf("failed to request access token: unsupported token type %q", body.TokenType)
+	}
+
+	t.token = body.AccessToken
+	t.expiry = time.Now().Add(time.Hour)
+	if body.ExpiresIn > 0 {
+		t.expiry = time.Now().Add(time.Duration(body.ExpiresIn)*time.Second - oauthExpiryDelta)
+	}
+
+	return t.token, nil
+}
+
+func (t *oauthTransport) invalidate(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token == token {
+		t.token = ""
+	}
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func newTokenServer(t *testing.T, token string, expiresIn int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != "terraform" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.PostFormValue("grant_type") != "client_credentials" || r.PostFormValue("scope") != "berth.admin berth.read" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token": token,
+			"token_type":   "Bearer",
+			"expires_in":   expiresIn,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func newOAuthTestClient(t *testing.T, tokenURL, clientSecret string) *Client {
+	t.Helper()
+
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+
+	return NewClient(Config{
+		URL: fake.URL,
+		OAuth: &OAuthConfig{
+			TokenURL:     tokenURL,
+			ClientID:     "terraform",
+			ClientSecret: clientSecret,
+			Scopes:       []string{"berth.admin", "berth.read"},
+		},
+	})
+}
+
+func TestOAuth_CachesToken(t *testing.T) {
+	tokenServer, calls := newTokenServer(t, berthtest.APIKey, 3600)
+	c := newOAuthTestClient(t, tokenServer.URL, "secret")
+
+	for range 3 {
+		if _, err := c.ListRoles(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Fatalf("expected 1 token request, got %d", got)
+	}
+}
+
+func TestOAuth_RefreshesExpiredToken(t *testing.T) {
+	tokenServer, calls := newTokenServer(t, berthtest.APIKey, 1)
+	c := newOAuthTestClient(t, tokenServer.URL, "secret")
+
+	for range 2 {
+		if _, err := c.ListRoles(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestOAuth_RefetchesTokenAfterUnauthorized(t *testing.T) {
+	tokenServer, calls := newTokenServer(t, "revoked", 3600)
+	c := newOAuthTestClient(t, tokenServer.URL, "secret")
+
+	for range 2 {
+		if _, err := c.ListRoles(context.Background()); err == nil {
+			t.Fatal("expected error with rejected token")
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 token requests, got %d", got)
+	}
+}
+
+func TestOAuth_InvalidClient(t *testing.T) {
+	tokenServer, _ := newTokenServer(t, berthtest.APIKey, 3600)
+	c := newOAuthTestClient(t, tokenServer.URL, "wrong")
+
+	_, err := c.ListRoles(context.Background())
+	if err == nil {
+		t.Fatal("expected error with invalid client credentials")
+	}
+	if want := "invalid_client"; !strings.Contains(err.Error(), want) {
+		t.Fatalf("expected error to contain %q, got %v", want, err)
+	}
+}
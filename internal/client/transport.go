@@ -0,0 +1,289 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type concurrencyLimitTransport struct {
+	base      http.RoundTripper
+	semaphore chan struct{}
+}
+
+func newConcurrencyLimitTransport(base http.RoundTripper, limit int) *concurrencyLimitTransport {
+	return &concurrencyLimitTransport{
+		base:      base,
+		semaphore: make(chan struct{}, limit),
+	}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isMutatingMethod(req.Method) {
+		return t.base.RoundTrip(req)
+	}
+
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.semaphore }()
+
+	return t.base.RoundTrip(req)
+}
+
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func newTimeoutTransport(base http.RoundTripper, timeout time.Duration) *timeoutTransport {
+	return &timeoutTransport{base: base, timeout: timeout}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+func newRetryTransport(base http.RoundTripper, maxRetries int, waitMin, waitMax time.Duration) *retryTransport {
+	return &retryTransport{
+		base:       base,
+		maxRetries: maxRetries,
+		waitMin:    waitMin,
+		waitMax:    waitMax,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retryable := isIdempotentMethod(req.Method) && (req.Body == nil || req.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if !retryable || attempt >= t.maxRetries || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp); ok {
+				wait = min(retryAfter, t.waitMax)
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.waitMin << attempt
+	if wait <= 0 || wait > t.waitMax {
+		wait = t.waitMax
+	}
+	if wait <= 1 {
+		return wait
+	}
+	return wait/2 + rand.N(wait/2)
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+type loggingTransport struct {
+	base http.RoundTripper
+}
+
+func newLoggingTransport(base http.RoundTripper) *loggingTransport {
+	return &loggingTransport{base: base}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	fields := map[string]any{
+		"method":          req.Method,
+		"path":            req.URL.Path,
+		"request_headers": redactHeaders(req.Header),
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Berth API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	if requestID := resp.Header.Get("X-Request-Id"); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	tflog.Debug(ctx, "Berth API request", fields)
+
+	return resp, nil
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for name, values := range header {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Cookie", "Proxy-Authorization":
+			redacted[name] = "[REDACTED]"
+		default:
+			redacted[name] = strings.Join(values, ", ")
+		}
+	}
+	return redacted
+}
+
+type rateLimitTransport struct {
+	base http.RoundTripper
+
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimitTransport(base http.RoundTripper, requestsPerSecond float64, burst int) *rateLimitTransport {
+	return &rateLimitTransport{
+		base:   base,
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	return t.base.RoundTrip(req)
+}
+
+func (t *rateLimitTransport) wait(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		now := time.Now()
+		t.tokens = min(t.burst, t.tokens+now.Sub(t.last).Seconds()*t.rate)
+		t.last = now
+
+		if t.tokens >= 1 {
+			t.tokens--
+			t.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - t.tokens) / t.rate * float64(time.Second))
+		t.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func newFlakyServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestRetryTransport_RetriesTransientErrors(t *testing.T) {
+	server, calls := newFlakyServer(t, 2, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 5*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Fatalf("expected success after 3 calls, got status %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Millisecond, 5*time.Millisecond)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable || calls.Load() != 3 {
+		t.Fatalf("expected 503 after 3 calls, got status %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryTransport_DoesNotRetryPost(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 5*time.Millisecond)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || calls.Load() != 1 {
+		t.Fatalf("expected a single call, got status %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestRetryTransport_RetriesPutWithBody(t *testing.T) {
+	var bodies []string
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, r.Body)
+		bodies = append(bodies, buf.String())
+		if calls == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 3, time.Millisecond, 5*time.Millisecond)}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Fatalf("expected the body to be resent, got %q", bodies)
+	}
+}
+
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, time.Millisecond, 50*time.Millisecond)}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("expected Retry-After to be capped at retry_wait_max, waited %s", elapsed)
+	}
+	if resp.StatusCode != http.StatusOK || calls.Load() != 2 {
+		t.Fatalf("expected success after 2 calls, got status %d after %d calls", resp.StatusCode, calls.Load())
+	}
+}
+
+func TestLoggingTransport_RedactsAuthorization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+	}))
+	t.Cleanup(server.Close)
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/admin/roles", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer secret-api-key")
+
+	resp, err := newLoggingTransport(http.DefaultTransport).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	logged := output.String()
+	if strings.Contains(logged, "secret-api-key") {
+		t.Fatalf("expected Authorization header to be redacted, got %s", logged)
+	}
+	for _, want := range []string{"/api/v1/admin/roles", "req-123", "[REDACTED]"} {
+		if !strings.Contains(logged, want) {
+			t.Fatalf("expected log output to contain %q, got %s", want, logged)
+		}
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	server, calls := newFlakyServer(t, 0, http.StatusOK, nil)
+	client := &http.Client{Transport: newRateLimitTransport(http.DefaultTransport, 20, 2)}
+
+	start := time.Now()
+	for range 5 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if elapsed := time.Since(start); elapsed < 140*time.Millisecond {
+		t.Fatalf("expected 3 requests beyond the burst to be delayed by ~150ms, took %s", elapsed)
+	}
+	if calls.Load() != 5 {
+		t.Fatalf("expected 5 calls, got %d", calls.Load())
+	}
+}
+
+func TestRateLimitTransport_ContextCancelled(t *testing.T) {
+	server, _ := newFlakyServer(t, 0, http.StatusOK, nil)
+	transport := newRateLimitTransport(http.DefaultTransport, 0.001, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	for i := range 2 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resp, err := transport.RoundTrip(req)
+		if i == 0 {
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			continue
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected the wait to stop when the context expires, got %v", err)
+		}
+	}
+}
+
+func TestConcurrencyLimitTransport_QueueWaitDoesNotCountTowardTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(40 * time.Millisecond)
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(server.Close)
+
+	transport := newConcurrencyLimitTransport(newTimeoutTransport(http.DefaultTransport, 60*time.Millisecond), 1)
+
+	errs := make(chan error, 3)
+	for range 3 {
+		go func() {
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("{}"))
+			if err != nil {
+				errs <- err
+				return
+			}
+			resp, err := transport.RoundTrip(req)
+			if err == nil {
+				_, err = io.ReadAll(resp.Body)
+				resp.Body.Close()
+			}
+			errs <- err
+		}()
+	}
+
+	for range 3 {
+		if err := <-errs; err != nil {
+			t.Fatalf("expected queued writes to get a full timeout once they start, got %v", err)
+		}
+	}
+}
+
+func TestRetryTransport_RateLimitWaitDoesNotUseAttempts(t *testing.T) {
+	server, calls := newFlakyServer(t, 0, http.StatusOK, nil)
+	transport := newRetryTransport(
+		newRateLimitTransport(newTimeoutTransport(http.DefaultTransport, 50*time.Millisecond), 10, 1),
+		1, time.Millisecond, 5*time.Millisecond,
+	)
+	client := &http.Client{Transport: transport}
+
+	for range 2 {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("expected the rate limit wait to not count toward the attempt timeout, got %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls.Load())
+	}
+}
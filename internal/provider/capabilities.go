@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+type plannedPermissionName struct {
+	Path path.Path
+	Name types.String
+}
+
+func plannedRolePermissionNames(ctx context.Context, plan tfsdk.Plan) ([]plannedPermissionName, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var names []plannedPermissionName
+
+	var permissions types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	for _, elem := range permissions.Elements() {
+		if name, ok := objectString(elem, "permission_name"); ok {
+			names = append(names, plannedPermissionName{
+				Path: path.Root("permissions").AtSetValue(elem).AtName("permission_name"),
+				Name: name,
+			})
+		}
+	}
+
+	var permissionSets types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("permission_set"), &permissionSets)...)
+	for _, elem := range permissionSets.Elements() {
+		set, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		setPermissions, ok := set.Attributes()["permissions"].(types.Set)
+		if !ok {
+			continue
+		}
+		for _, permission := range setPermissions.Elements() {
+			if name, ok := objectString(permission, "name"); ok {
+				names = append(names, plannedPermissionName{
+					Path: path.Root("permission_set").AtSetValue(elem).AtName("permissions").AtSetValue(permission).AtName("name"),
+					Name: name,
+				})
+			}
+		}
+	}
+
+	return names, diags
+}
+
+func objectString(value any, name string) (types.String, bool) {
+	object, ok := value.(types.Object)
+	if !ok {
+		return types.String{}, false
+	}
+	attribute, ok := object.Attributes()[name].(types.String)
+	return attribute, ok
+}
+
+func (p *ProviderData) supportsFeature(ctx context.Context, feature client.Feature) (bool, error) {
+	p.capabilitiesMu.Lock()
+	defer p.capabilitiesMu.Unlock()
+
+	if supported, ok := p.features[feature]; ok {
+		return supported, nil
+	}
+
+	supported, err := p.Client.SupportsFeature(ctx, feature)
+	if err != nil {
+		return false, err
+	}
+
+	if p.features == nil {
+		p.features = make(map[client.Feature]bool)
+	}
+	p.features[feature] = supported
+
+	tflog.Debug(ctx, "Probed Berth feature", map[string]any{
+		"feature":   string(feature),
+		"supported": supported,
+	})
+
+	return supported, nil
+}
+
+func (p *ProviderData) berthRelease(ctx context.Context) string {
+	p.capabilitiesMu.Lock()
+	defer p.capabilitiesMu.Unlock()
+
+	if p.version == "" {
+		if version, err := p.Client.GetVersion(ctx); err == nil {
+			p.version = version
+		}
+	}
+
+	if p.version == "" {
+		return "this Berth server"
+	}
+	return "Berth " + p.version
+}
+
+func checkPermissionsSupported(ctx context.Context, data *ProviderData, names []plannedPermissionName) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		return diags
+	}
+
+	for _, n := range names {
+		if n.Name.IsNull() || n.Name.IsUnknown() {
+			continue
+		}
+
+		_, err := data.Client.GetPermissionByName(ctx, n.Name.ValueString())
+		var apiErr *client.APIError
+		switch {
+		case err == nil:
+			continue
+		case errors.As(err, &apiErr) || !client.IsNotFound(err):
+			diags.AddWarning(
+				"Unable to check Berth permissions",
+				fmt.Sprintf("Reading the Berth permission catalog failed, so permission names are not checked at plan time: %s", err),
+			)
+			return diags
+		}
+
+		diags.AddAttributeError(
+			n.Path,
+			"Permission not supported by Berth server",
+			fmt.Sprintf(
+				"The requested permission is not provided by %s: %s. Check the name for typos; if it is correct, it may require a newer Berth release.",
+				data.berthRelease(ctx), err,
+			),
+		)
+	}
+
+	return diags
+}
+
+func checkFeatureSupported(ctx context.Context, data *ProviderData, typeName string, feature client.Feature) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		return diags
+	}
+
+	supported, err := data.supportsFeature(ctx, feature)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to check Berth capabilities",
+			fmt.Sprintf("Probing the Berth %s API failed, so %s is not checked for compatibility at plan time: %s", feature, typeName, err),
+		)
+		return diags
+	}
+	if supported {
+		return diags
+	}
+
+	diags.AddError(
+		"Resource not supported by Berth server",
+		fmt.Sprintf("%s needs the Berth %s API, which %s does not provide. Upgrade Berth to a release that includes it.", typeName, feature, data.berthRelease(ctx)),
+	)
+
+	return diags
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+const stackFilesReadPattern = "/api/v1/servers/{id}/stacks/{name}/files/read"
+
+func TestResourcesRequireBerthFeatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource func() resource.Resource
+		model    any
+		disable  func(fake *berthtest.FakeServer)
+	}{
+		{
+			name:     "berth_user",
+			resource: NewUserResource,
+			model: UserResourceModel{
+				ID:          types.StringUnknown(),
+				Username:    types.StringValue("alice"),
+				Email:       types.StringValue("alice@example.com"),
+				Password:    types.StringNull(),
+				TOTPEnabled: types.BoolUnknown(),
+				CreatedAt:   types.StringUnknown(),
+			},
+			disable: func(fake *berthtest.FakeServer) {
+				fake.DisableEndpoint(http.MethodGet, "/api/v1/admin/users")
+			},
+		},
+		{
+			name:     "berth_server",
+			resource: NewServerResource,
+			model: ServerResourceModel{
+				ID:                  types.StringUnknown(),
+				Name:                types.StringValue("prod"),
+				Description:         types.StringValue(""),
+				Host:                types.StringValue("10.0.0.1"),
+				Port:                types.Int64Value(8081),
+				AccessToken:         types.StringValue("agent-token"),
+				SkipSSLVerification: types.BoolValue(false),
+				IsActive:            types.BoolValue(true),
+			},
+			disable: func(fake *berthtest.FakeServer) {
+				fake.DisableEndpoint(http.MethodGet, "/api/v1/admin/servers")
+			},
+		},
+		{
+			name:     "berth_stack",
+			resource: NewStackResource,
+			model: StackResourceModel{
+				ID:             types.StringUnknown(),
+				ServerID:       types.Int64Value(1),
+				Name:           types.StringValue("web"),
+				ComposeContent: types.StringValue(testComposeContent),
+				ComposeFile:    types.StringUnknown(),
+				Path:           types.StringUnknown(),
+				ComposeSHA256:  types.StringUnknown(),
+			},
+			disable: func(fake *berthtest.FakeServer) {
+				fake.DisableEndpoint(http.MethodGet, stackFilesReadPattern)
+			},
+		},
+		{
+			name:     "berth_stack_env_file",
+			resource: NewStackEnvFileResource,
+			model: StackEnvFileResourceModel{
+				ID:            types.StringUnknown(),
+				ServerID:      types.Int64Value(1),
+				StackName:     types.StringValue("web"),
+				Content:       types.StringValue("PORT=8080\n"),
+				ContentSHA256: types.StringUnknown(),
+			},
+			disable: func(fake *berthtest.FakeServer) {
+				fake.DisableEndpoint(http.MethodGet, stackFilesReadPattern)
+			},
+		},
+		{
+			name:     "berth_stack_environment",
+			resource: NewStackEnvironmentResource,
+			model: StackEnvironmentResourceModel{
+				ID:                 types.StringUnknown(),
+				ServerID:           types.Int64Value(1),
+				StackName:          types.StringValue("web"),
+				Variables:          types.MapNull(types.StringType),
+				SensitiveVariables: types.MapNull(types.StringType),
+				ContentSHA256:      types.StringUnknown(),
+			},
+			disable: func(fake *berthtest.FakeServer) {
+				fake.DisableEndpoint(http.MethodGet, stackFilesReadPattern)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, c := newTestClient(t)
+			requireNoDiags(t, newResourceHarness(t, tt.resource(), c).modifyPlan(tt.model))
+
+			tt.disable(fake)
+			requireDiagnostics(t, newResourceHarness(t, tt.resource(), c).modifyPlan(tt.model), "Resource not supported by Berth server", "")
+		})
+	}
+}
+
+func TestFeatureProbesAreLazyAndCached(t *testing.T) {
+	fake, c := newTestClient(t)
+	data := newProviderData(c)
+
+	stack := newResourceHarnessWithData(t, NewStackResource(), data)
+	env := newResourceHarnessWithData(t, NewStackEnvFileResource(), data)
+	if fake.RequestCount(http.MethodGet, "/api/v1/admin/users") != 0 || fake.RequestCount(http.MethodGet, "/api/v1/version") != 0 {
+		t.Fatal("expected no probes before a resource is planned")
+	}
+
+	model := StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(1),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("PORT=8080\n"),
+		ContentSHA256: types.StringUnknown(),
+	}
+	requireNoDiags(t, env.modifyPlan(model))
+	requireNoDiags(t, env.modifyPlan(model))
+	requireNoDiags(t, stack.modifyPlan(StackResourceModel{
+		ID:             types.StringUnknown(),
+		ServerID:       types.Int64Value(1),
+		Name:           types.StringValue("web"),
+		ComposeContent: types.StringValue(testComposeContent),
+		ComposeFile:    types.StringUnknown(),
+		Path:           types.StringUnknown(),
+		ComposeSHA256:  types.StringUnknown(),
+	}))
+
+	if got := fake.RequestCount(http.MethodGet, "/api/v1/servers/0/stacks/_/files/read"); got != 1 {
+		t.Fatalf("expected the stack files API to be probed once, got %d", got)
+	}
+	if fake.RequestCount(http.MethodGet, "/api/v1/admin/users") != 0 || fake.RequestCount(http.MethodGet, "/api/v1/admin/servers") != 0 {
+		t.Fatal("expected only the stack files API to be probed")
+	}
+}
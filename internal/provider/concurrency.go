@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+func runConcurrently(limit, n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	semaphore := make(chan struct{}, max(limit, 1))
+
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+
+	for i := range n {
+		semaphore <- struct{}{}
+		if failed.Load() {
+			<-semaphore
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := fn(i); err != nil {
+				errs[i] = err
+				failed.Store(true)
+			}
+		}()
+	}
+
+	wg.Wait()
+	return errs
+}
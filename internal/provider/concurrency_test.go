@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunConcurrently(t *testing.T) {
+	var running, peak atomic.Int32
+	results := make([]int, 20)
+
+	errs := runConcurrently(3, len(results), func(i int) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			seen := peak.Load()
+			if current <= seen || peak.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		results[i] = i * 2
+		return nil
+	})
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("unexpected error at %d: %v", i, err)
+		}
+		if results[i] != i*2 {
+			t.Fatalf("expected result %d at %d, got %d", i*2, i, results[i])
+		}
+	}
+	if peak.Load() > 3 {
+		t.Fatalf("expected at most 3 concurrent calls, got %d", peak.Load())
+	}
+}
+
+func TestRunConcurrently_StopsAfterFailure(t *testing.T) {
+	var calls atomic.Int32
+	failure := errors.New("boom")
+
+	errs := runConcurrently(1, 10, func(i int) error {
+		calls.Add(1)
+		if i == 2 {
+			return failure
+		}
+		return nil
+	})
+
+	if calls.Load() != 3 {
+		t.Fatalf("expected no calls after the failure, got %d", calls.Load())
+	}
+	for i, err := range errs {
+		if (i == 2) != errors.Is(err, failure) {
+			t.Fatalf("unexpected error at %d: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &ContainerDataSource{}
+
+func NewContainerDataSource() datasource.DataSource {
+	return &ContainerDataSource{}
+}
+
+type ContainerDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type ContainerDataSourceModel struct {
+	ID        types.String            `tfsdk:"id"`
+	ServerID  types.Int64             `tfsdk:"server_id"`
+	StackName types.String            `tfsdk:"stack_name"`
+	Service   types.String            `tfsdk:"service"`
+	Name      types.String            `tfsdk:"name"`
+	Image     types.String            `tfsdk:"image"`
+	ImageID   types.String            `tfsdk:"image_id"`
+	State     types.String            `tfsdk:"state"`
+	Health    types.String            `tfsdk:"health"`
+	Mounts    []ContainerMountModel   `tfsdk:"mounts"`
+	Networks  []ContainerNetworkModel `tfsdk:"networks"`
+	Ports     []ContainerPortModel    `tfsdk:"ports"`
+}
+
+type ContainerMountModel struct {
+	Type        types.String `tfsdk:"type"`
+	Source      types.String `tfsdk:"source"`
+	Destination types.String `tfsdk:"destination"`
+	ReadWrite   types.Bool   `tfsdk:"read_write"`
+}
+
+type ContainerNetworkModel struct {
+	Name      types.String `tfsdk:"name"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	Gateway   types.String `tfsdk:"gateway"`
+}
+
+type ContainerPortModel struct {
+	PrivatePort types.Int64  `tfsdk:"private_port"`
+	PublicPort  types.Int64  `tfsdk:"public_port"`
+	Protocol    types.String `tfsdk:"protocol"`
+}
+
+func (d *ContainerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_container"
+}
+
+func (d *ContainerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Inspects a single container of a Berth stack service",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier in format 'server_id:stack_name:container_name'",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+			},
+			"service": schema.StringAttribute{
+				Description: "Compose service name",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Container name. Required when the service runs more than one container",
+				Optional:    true,
+				Computed:    true,
+			},
+			"image": schema.StringAttribute{
+				Description: "Image reference the container was created from",
+				Computed:    true,
+			},
+			"image_id": schema.StringAttribute{
+				Description: "Image ID (digest) of the running container",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Container state (e.g., 'running', 'exited')",
+				Computed:    true,
+			},
+			"health": schema.StringAttribute{
+				Description: "Health check status, empty if the container has no health check",
+				Computed:    true,
+			},
+			"mounts": schema.ListNestedAttribute{
+				Description: "Mounts attached to the container",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Mount type (e.g., 'bind', 'volume')",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Mount source on the host",
+							Computed:    true,
+						},
+						"destination": schema.StringAttribute{
+							Description: "Mount destination inside the container",
+							Computed:    true,
+						},
+						"read_write": schema.BoolAttribute{
+							Description: "Whether the mount is writable",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "Networks the container is attached to",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Network name",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "Container IP address on the network",
+							Computed:    true,
+						},
+						"gateway": schema.StringAttribute{
+							Description: "Network gateway",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"ports": schema.ListNestedAttribute{
+				Description: "Ports exposed by the container",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"private_port": schema.Int64Attribute{
+							Description: "Container port",
+							Computed:    true,
+						},
+						"public_port": schema.Int64Attribute{
+							Description: "Published host port, 0 if not published",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Port protocol (e.g., 'tcp', 'udp')",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ContainerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *ContainerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ContainerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.StackName.ValueString()
+
+	services, err := d.client.GetStackServices(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	var service *client.StackService
+	for i := range services {
+		if services[i].Name == data.Service.ValueString() {
+			service = &services[i]
+			break
+		}
+	}
+	if service == nil {
+		resp.Diagnostics.AddError("Service not found", fmt.Sprintf("Stack '%s' has no service named '%s'", stackName, data.Service.ValueString()))
+		return
+	}
+
+	var container *client.Container
+	switch {
+	case !data.Name.IsNull():
+		for i := range service.Containers {
+			if service.Containers[i].Name == data.Name.ValueString() {
+				container = &service.Containers[i]
+				break
+			}
+		}
+	case len(service.Containers) == 1:
+		container = &service.Containers[0]
+	case len(service.Containers) > 1:
+		resp.Diagnostics.AddError("Multiple containers found", fmt.Sprintf("Service '%s' runs %d containers; set name to select one", service.Name, len(service.Containers)))
+		return
+	}
+	if container == nil {
+		resp.Diagnostics.AddError("Container not found", fmt.Sprintf("No matching container found for service '%s'", service.Name))
+		return
+	}
+
+	images, err := d.client.ListStackImages(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack images", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	data.ImageID = types.StringValue("")
+	for _, img := range images {
+		if img.ContainerName == container.Name {
+			data.ImageID = types.StringValue(img.ImageID)
+			break
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s:%s", serverID, stackName, container.Name))
+	data.Name = types.StringValue(container.Name)
+	data.Image = types.StringValue(container.Image)
+	data.State = types.StringValue(container.State)
+	data.Health = types.StringValue(container.Health)
+
+	data.Mounts = make([]ContainerMountModel, 0, len(container.Mounts))
+	for _, m := range container.Mounts {
+		data.Mounts = append(data.Mounts, ContainerMountModel{
+			Type:        types.StringValue(m.Type),
+			Source:      types.StringValue(m.Source),
+			Destination: types.StringValue(m.Destination),
+			ReadWrite:   types.BoolValue(m.ReadWrite),
+		})
+	}
+
+	data.Networks = make([]ContainerNetworkModel, 0, len(container.Networks))
+	for _, n := range container.Networks {
+		data.Networks = append(data.Networks, ContainerNetworkModel{
+			Name:      types.StringValue(n.Name),
+			IPAddress: types.StringValue(n.IPAddress),
+			Gateway:   types.StringValue(n.Gateway),
+		})
+	}
+
+	data.Ports = make([]ContainerPortModel, 0, len(container.Ports))
+	for _, p := range container.Ports {
+		data.Ports = append(data.Ports, ContainerPortModel{
+			PrivatePort: types.Int64Value(int64(p.Private)),
+			PublicPort:  types.Int64Value(int64(p.Public)),
+			Protocol:    types.StringValue(p.Protocol),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
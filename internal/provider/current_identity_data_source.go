@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &CurrentIdentityDataSource{}
+
+func NewCurrentIdentityDataSource() datasource.DataSource {
+	return &CurrentIdentityDataSource{}
+}
+
+type CurrentIdentityDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type CurrentIdentityDataSourceModel struct {
+	ID       types.String    `tfsdk:"id"`
+	Username types.String    `tfsdk:"username"`
+	Email    types.String    `tfsdk:"email"`
+	IsAdmin  types.Bool      `tfsdk:"is_admin"`
+	Roles    []UserRoleModel `tfsdk:"roles"`
+}
+
+func (d *CurrentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_identity"
+}
+
+func (d *CurrentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the Berth user that the provider's API key belongs to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "User ID",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username",
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address",
+				Computed:    true,
+			},
+			"is_admin": schema.BoolAttribute{
+				Description: "Whether any of the user's roles is an admin role",
+				Computed:    true,
+			},
+			"roles": userRolesSchema(),
+		},
+	}
+}
+
+func (d *CurrentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *CurrentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CurrentIdentityDataSourceModel
+
+	user, err := d.client.GetCurrentUser(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read current identity", apiErrorDetail(d.providerData, "Current user", err))
+		return
+	}
+
+	isAdmin := false
+	for _, role := range user.Roles {
+		if role.IsAdmin {
+			isAdmin = true
+			break
+		}
+	}
+
+	data.ID = types.StringValue(strconv.FormatUint(uint64(user.ID), 10))
+	data.Username = types.StringValue(user.Username)
+	data.Email = types.StringValue(user.Email)
+	data.IsAdmin = types.BoolValue(isAdmin)
+	data.Roles = userRoleModels(user.Roles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCurrentIdentityDataSource(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("automation", "automation@example.com")
+	role := fake.AddAdminRole("admin")
+	if err := c.AssignUserRole(context.Background(), uint(user.ID), uint(role.ID)); err != nil {
+		t.Fatal(err)
+	}
+	fake.SetCurrentUser(user.ID)
+
+	state, diags := readDataSource(t, NewCurrentIdentityDataSource(), c, CurrentIdentityDataSourceModel{
+		ID:       types.StringNull(),
+		Username: types.StringNull(),
+		Email:    types.StringNull(),
+		IsAdmin:  types.BoolNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data CurrentIdentityDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.Username.ValueString() != "automation" || !data.IsAdmin.ValueBool() {
+		t.Fatalf("unexpected identity: %+v", data)
+	}
+	if len(data.Roles) != 1 || data.Roles[0].Name.ValueString() != "admin" {
+		t.Fatalf("unexpected roles: %+v", data.Roles)
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const (
+	authAPIKey        = "api_key"
+	authAPIKeyFile    = "api_key_file"
+	authAPIKeyCommand = "api_key_command"
+	authOAuth         = "oauth"
+)
+
+func apiErrorDetail(data *ProviderData, subject string, err error) string {
+	detail := fmt.Sprintf("%s: %s", subject, err)
+
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return detail
+	}
+
+	authMethod := authAPIKey
+	if data != nil && data.AuthMethod != "" {
+		authMethod = data.AuthMethod
+	}
+
+	var hint string
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		hint = unauthorizedHint(authMethod)
+	case http.StatusForbidden:
+		hint = forbiddenHint(authMethod)
+	case http.StatusNotFound:
+		hint = "The object may have been deleted outside of Terraform. Run terraform refresh to update the state."
+	case http.StatusConflict:
+		hint = "An object with the same identity already exists. Import it with terraform import or change the configuration."
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		hint = "Berth rejected the request. Check the configured values for the fields reported above."
+	}
+
+	if hint == "" {
+		return detail
+	}
+	return detail + "\n\n" + hint
+}
+
+func unauthorizedHint(authMethod string) string {
+	switch authMethod {
+	case authAPIKeyFile:
+		return "Check that the API key stored in the provider's api_key_file is valid and has not been revoked."
+	case authAPIKeyCommand:
+		return "Check that the API key printed by the provider's api_key_command is valid and has not been revoked."
+	case authOAuth:
+		return "Check that the provider's oauth client_id and client_secret are valid and that Berth accepts tokens issued by the configured token_url."
+	default:
+		return "Check that the provider's api_key (or BERTH_API_KEY) is valid and has not been revoked."
+	}
+}
+
+func forbiddenHint(authMethod string) string {
+	if authMethod == authOAuth {
+		return "The provider's oauth client must be granted admin privileges in Berth."
+	}
+	return fmt.Sprintf("The API key configured through %s must belong to a user with admin privileges.", authMethod)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+func TestAPIErrorDetail_AuthHints(t *testing.T) {
+	unauthorized := &client.APIError{StatusCode: http.StatusUnauthorized, Message: "invalid token"}
+	forbidden := &client.APIError{StatusCode: http.StatusForbidden, Message: "admin required"}
+
+	tests := []struct {
+		name       string
+		data       *ProviderData
+		err        error
+		wantSuffix string
+	}{
+		{
+			name:       "no provider data",
+			data:       nil,
+			err:        unauthorized,
+			wantSuffix: "Check that the provider's api_key (or BERTH_API_KEY) is valid and has not been revoked.",
+		},
+		{
+			name:       "api_key_file",
+			data:       &ProviderData{AuthMethod: authAPIKeyFile},
+			err:        unauthorized,
+			wantSuffix: "Check that the API key stored in the provider's api_key_file is valid and has not been revoked.",
+		},
+		{
+			name:       "api_key_command",
+			data:       &ProviderData{AuthMethod: authAPIKeyCommand},
+			err:        unauthorized,
+			wantSuffix: "Check that the API key printed by the provider's api_key_command is valid and has not been revoked.",
+		},
+		{
+			name:       "oauth unauthorized",
+			data:       &ProviderData{AuthMethod: authOAuth},
+			err:        unauthorized,
+			wantSuffix: "Check that the provider's oauth client_id and client_secret are valid and that Berth accepts tokens issued by the configured token_url.",
+		},
+		{
+			name:       "oauth forbidden",
+			data:       &ProviderData{AuthMethod: authOAuth},
+			err:        forbidden,
+			wantSuffix: "The provider's oauth client must be granted admin privileges in Berth.",
+		},
+		{
+			name:       "api_key_file forbidden",
+			data:       &ProviderData{AuthMethod: authAPIKeyFile},
+			err:        forbidden,
+			wantSuffix: "The API key configured through api_key_file must belong to a user with admin privileges.",
+		},
+		{
+			name:       "not an API error",
+			data:       &ProviderData{AuthMethod: authOAuth},
+			err:        errors.New("connection refused"),
+			wantSuffix: "Roles: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detail := apiErrorDetail(tt.data, "Roles", tt.err)
+			if !strings.HasPrefix(detail, "Roles: ") || !strings.HasSuffix(detail, tt.wantSuffix) {
+				t.Fatalf("unexpected detail: %q", detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const composeProjectLabel = "com.docker.compose.project"
+
+var _ datasource.DataSource = &DockerNetworksDataSource{}
+
+func NewDockerNetworksDataSource() datasource.DataSource {
+	return &DockerNetworksDataSource{}
+}
+
+type DockerNetworksDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type DockerNetworksDataSourceModel struct {
+	ID       types.String         `tfsdk:"id"`
+	ServerID types.Int64          `tfsdk:"server_id"`
+	Names    []types.String       `tfsdk:"names"`
+	Networks []DockerNetworkModel `tfsdk:"networks"`
+}
+
+type DockerNetworkModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Driver         types.String   `tfsdk:"driver"`
+	Scope          types.String   `tfsdk:"scope"`
+	Internal       types.Bool     `tfsdk:"internal"`
+	Unused         types.Bool     `tfsdk:"unused"`
+	Subnets        []types.String `tfsdk:"subnets"`
+	AttachedStacks []types.String `tfsdk:"attached_stacks"`
+}
+
+func (d *DockerNetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_networks"
+}
+
+func (d *DockerNetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Docker networks present on a Berth server",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+			},
+			"names": schema.ListAttribute{
+				Description: "Names of all networks on the server",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "Networks on the server",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Docker network ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Network name",
+							Computed:    true,
+						},
+						"driver": schema.StringAttribute{
+							Description: "Network driver (e.g., 'bridge', 'overlay')",
+							Computed:    true,
+						},
+						"scope": schema.StringAttribute{
+							Description: "Network scope (e.g., 'local', 'swarm')",
+							Computed:    true,
+						},
+						"internal": schema.BoolAttribute{
+							Description: "Whether the network is internal",
+							Computed:    true,
+						},
+						"unused": schema.BoolAttribute{
+							Description: "Whether no container is attached to the network",
+							Computed:    true,
+						},
+						"subnets": schema.ListAttribute{
+							Description: "Subnets assigned to the network",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"attached_stacks": schema.ListAttribute{
+							Description: "Names of stacks with containers attached to the network",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DockerNetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *DockerNetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DockerNetworksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+
+	networks, err := d.client.ListNetworks(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list networks", apiErrorDetail(d.providerData, fmt.Sprintf("Server %d", serverID), err))
+		return
+	}
+
+	stacks, err := d.client.ListStacks(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list stacks", apiErrorDetail(d.providerData, fmt.Sprintf("Server %d", serverID), err))
+		return
+	}
+
+	subnets := make(map[string]map[string]bool)
+	attached := make(map[string]map[string]bool)
+	add := func(m map[string]map[string]bool, network, value string) {
+		if m[network] == nil {
+			m[network] = make(map[string]bool)
+		}
+		m[network][value] = true
+	}
+
+	known := make(map[string]bool, len(networks))
+	for _, n := range networks {
+		known[n.Name] = true
+		if n.Subnet != "" {
+			add(subnets, n.Name, n.Subnet)
+		}
+	}
+
+	for _, stack := range stacks {
+		stackNetworks, err := d.client.ListStackNetworks(ctx, serverID, stack.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list stack networks", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stack.Name, serverID), err))
+			return
+		}
+
+		for _, n := range stackNetworks {
+			if !n.Exists {
+				continue
+			}
+
+			name := n.Name
+			if !known[name] && known[stack.Name+"_"+name] {
+				name = stack.Name + "_" + name
+			}
+
+			for _, subnet := range n.Subnets {
+				add(subnets, name, subnet)
+			}
+			if len(n.Containers) > 0 {
+				add(attached, name, stack.Name)
+			}
+		}
+	}
+
+	data.Names = make([]types.String, 0, len(networks))
+	data.Networks = make([]DockerNetworkModel, 0, len(networks))
+	for _, n := range networks {
+		if project := n.Labels[composeProjectLabel]; project != "" && !n.Unused {
+			add(attached, n.Name, project)
+		}
+
+		data.Names = append(data.Names, types.StringValue(n.Name))
+		data.Networks = append(data.Networks, DockerNetworkModel{
+			ID:             types.StringValue(n.ID),
+			Name:           types.StringValue(n.Name),
+			Driver:         types.StringValue(n.Driver),
+			Scope:          types.StringValue(n.Scope),
+			Internal:       types.BoolValue(n.Internal),
+			Unused:         types.BoolValue(n.Unused),
+			Subnets:        sortedStringValues(subnets[n.Name]),
+			AttachedStacks: sortedStringValues(attached[n.Name]),
+		})
+	}
+
+	data.ID = types.StringValue(strconv.FormatUint(uint64(serverID), 10))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func sortedStringValues(set map[string]bool) []types.String {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]types.String, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, types.StringValue(k))
+	}
+	return values
+}
@@ -0,0 +1,265 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &EventsDataSource{}
+
+func NewEventsDataSource() datasource.DataSource {
+	return &EventsDataSource{}
+}
+
+type EventsDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type EventsDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	EventType     types.String `tfsdk:"event_type"`
+	EventCategory types.String `tfsdk:"event_category"`
+	Severity      types.String `tfsdk:"severity"`
+	Success       types.Bool   `tfsdk:"success"`
+	Since         types.String `tfsdk:"since"`
+	StartDate     types.String `tfsdk:"start_date"`
+	EndDate       types.String `tfsdk:"end_date"`
+	Search        types.String `tfsdk:"search"`
+	Limit         types.Int64  `tfsdk:"limit"`
+	Count         types.Int64  `tfsdk:"count"`
+	Events        []EventModel `tfsdk:"events"`
+}
+
+type EventModel struct {
+	ID            types.String `tfsdk:"id"`
+	EventType     types.String `tfsdk:"event_type"`
+	EventCategory types.String `tfsdk:"event_category"`
+	Severity      types.String `tfsdk:"severity"`
+	Success       types.Bool   `tfsdk:"success"`
+	ActorUsername types.String `tfsdk:"actor_username"`
+	ActorIP       types.String `tfsdk:"actor_ip"`
+	TargetType    types.String `tfsdk:"target_type"`
+	TargetName    types.String `tfsdk:"target_name"`
+	ServerID      types.Int64  `tfsdk:"server_id"`
+	StackName     types.String `tfsdk:"stack_name"`
+	FailureReason types.String `tfsdk:"failure_reason"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+}
+
+func (d *EventsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+}
+
+func (d *EventsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Queries recent events from the Berth security audit log",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"event_type": schema.StringAttribute{
+				Description: "Only return events of this type",
+				Optional:    true,
+			},
+			"event_category": schema.StringAttribute{
+				Description: "Only return events in this category",
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "Only return events with this severity",
+				Optional:    true,
+			},
+			"success": schema.BoolAttribute{
+				Description: "Only return successful (true) or failed (false) events",
+				Optional:    true,
+			},
+			"since": schema.StringAttribute{
+				Description: "Only return events newer than this duration (e.g., '10m', '24h'). Conflicts with start_date",
+				Optional:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "Only return events at or after this time (RFC 3339)",
+				Optional:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Only return events at or before this time (RFC 3339)",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Search in actor username, target name, or event type",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of events to return (most recent first). Defaults to 100",
+				Optional:    true,
+			},
+			"count": schema.Int64Attribute{
+				Description: "Number of events returned",
+				Computed:    true,
+			},
+			"events": schema.ListNestedAttribute{
+				Description: "Matching events",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Event ID",
+							Computed:    true,
+						},
+						"event_type": schema.StringAttribute{
+							Description: "Event type",
+							Computed:    true,
+						},
+						"event_category": schema.StringAttribute{
+							Description: "Event category",
+							Computed:    true,
+						},
+						"severity": schema.StringAttribute{
+							Description: "Event severity",
+							Computed:    true,
+						},
+						"success": schema.BoolAttribute{
+							Description: "Whether the audited action succeeded",
+							Computed:    true,
+						},
+						"actor_username": schema.StringAttribute{
+							Description: "Username of the actor",
+							Computed:    true,
+						},
+						"actor_ip": schema.StringAttribute{
+							Description: "IP address of the actor",
+							Computed:    true,
+						},
+						"target_type": schema.StringAttribute{
+							Description: "Type of the affected object",
+							Computed:    true,
+						},
+						"target_name": schema.StringAttribute{
+							Description: "Name of the affected object",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID, null if the event is not tied to a server",
+							Computed:    true,
+						},
+						"stack_name": schema.StringAttribute{
+							Description: "Stack name, empty if the event is not tied to a stack",
+							Computed:    true,
+						},
+						"failure_reason": schema.StringAttribute{
+							Description: "Failure reason for unsuccessful events",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Time the event was recorded (RFC 3339)",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EventsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *EventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Since.IsNull() && !data.StartDate.IsNull() {
+		resp.Diagnostics.AddError("Conflicting filters", "Only one of since and start_date can be set")
+		return
+	}
+
+	filter := client.SecurityEventFilter{
+		EventType:     data.EventType.ValueString(),
+		EventCategory: data.EventCategory.ValueString(),
+		Severity:      data.Severity.ValueString(),
+		StartDate:     data.StartDate.ValueString(),
+		EndDate:       data.EndDate.ValueString(),
+		Search:        data.Search.ValueString(),
+		Limit:         100,
+	}
+
+	if !data.Success.IsNull() {
+		success := data.Success.ValueBool()
+		filter.Success = &success
+	}
+
+	if !data.Since.IsNull() {
+		since, err := time.ParseDuration(data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid since duration", err.Error())
+			return
+		}
+		filter.StartDate = time.Now().Add(-since).UTC().Format(time.RFC3339)
+	}
+
+	if !data.Limit.IsNull() {
+		filter.Limit = int(data.Limit.ValueInt64())
+	}
+
+	events, err := d.client.ListSecurityEvents(ctx, filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list events", apiErrorDetail(d.providerData, "Security events", err))
+		return
+	}
+
+	data.Events = make([]EventModel, 0, len(events))
+	for _, e := range events {
+		event := EventModel{
+			ID:            types.StringValue(strconv.FormatUint(uint64(e.ID), 10)),
+			EventType:     types.StringValue(e.EventType),
+			EventCategory: types.StringValue(e.EventCategory),
+			Severity:      types.StringValue(e.Severity),
+			Success:       types.BoolValue(e.Success),
+			ActorUsername: types.StringValue(e.ActorUsername),
+			ActorIP:       types.StringValue(e.ActorIP),
+			TargetType:    types.StringValue(e.TargetType),
+			TargetName:    types.StringValue(e.TargetName),
+			ServerID:      types.Int64Null(),
+			StackName:     types.StringValue(e.StackName),
+			FailureReason: types.StringValue(e.FailureReason),
+			CreatedAt:     types.StringValue(e.CreatedAt),
+		}
+		if e.ServerID != nil {
+			event.ServerID = types.Int64Value(int64(*e.ServerID))
+		}
+		data.Events = append(data.Events, event)
+	}
+
+	data.ID = types.StringValue("events")
+	data.Count = types.Int64Value(int64(len(data.Events)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const (
+	stackStatusRunning  = "running"
+	stackStatusDegraded = "degraded"
+	stackStatusStopped  = "stopped"
+)
+
+var _ datasource.DataSource = &FleetHealthDataSource{}
+
+func NewFleetHealthDataSource() datasource.DataSource {
+	return &FleetHealthDataSource{}
+}
+
+type FleetHealthDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type FleetHealthDataSourceModel struct {
+	ID                 types.String             `tfsdk:"id"`
+	ServerCount        types.Int64              `tfsdk:"server_count"`
+	UnreachableServers types.Int64              `tfsdk:"unreachable_servers"`
+	StackCount         types.Int64              `tfsdk:"stack_count"`
+	Running            types.Int64              `tfsdk:"running"`
+	Degraded           types.Int64              `tfsdk:"degraded"`
+	Stopped            types.Int64              `tfsdk:"stopped"`
+	Servers            []FleetHealthServerModel `tfsdk:"servers"`
+}
+
+type FleetHealthServerModel struct {
+	ServerID   types.Int64             `tfsdk:"server_id"`
+	ServerName types.String            `tfsdk:"server_name"`
+	IsActive   types.Bool              `tfsdk:"is_active"`
+	Reachable  types.Bool              `tfsdk:"reachable"`
+	Error      types.String            `tfsdk:"error"`
+	StackCount types.Int64             `tfsdk:"stack_count"`
+	Running    types.Int64             `tfsdk:"running"`
+	Degraded   types.Int64             `tfsdk:"degraded"`
+	Stopped    types.Int64             `tfsdk:"stopped"`
+	Stacks     []FleetHealthStackModel `tfsdk:"stacks"`
+}
+
+type FleetHealthStackModel struct {
+	Name              types.String `tfsdk:"name"`
+	Status            types.String `tfsdk:"status"`
+	IsHealthy         types.Bool   `tfsdk:"is_healthy"`
+	RunningContainers types.Int64  `tfsdk:"running_containers"`
+	TotalContainers   types.Int64  `tfsdk:"total_containers"`
+}
+
+func (d *FleetHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fleet_health"
+}
+
+func (d *FleetHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	rollupAttributes := func(scope string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"stack_count": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of stacks %s", scope),
+				Computed:    true,
+			},
+			"running": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of stacks %s with all containers running and healthy", scope),
+				Computed:    true,
+			},
+			"degraded": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of stacks %s with some containers stopped or unhealthy", scope),
+				Computed:    true,
+			},
+			"stopped": schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of stacks %s with no running containers", scope),
+				Computed:    true,
+			},
+		}
+	}
+
+	serverAttributes := rollupAttributes("on this server")
+	serverAttributes["server_id"] = schema.Int64Attribute{
+		Description: "Server ID",
+		Computed:    true,
+	}
+	serverAttributes["server_name"] = schema.StringAttribute{
+		Description: "Server name",
+		Computed:    true,
+	}
+	serverAttributes["is_active"] = schema.BoolAttribute{
+		Description: "Whether the server is active in Berth. Inactive servers are not queried",
+		Computed:    true,
+	}
+	serverAttributes["reachable"] = schema.BoolAttribute{
+		Description: "Whether the server's stacks could be listed",
+		Computed:    true,
+	}
+	serverAttributes["error"] = schema.StringAttribute{
+		Description: "Error returned while listing stacks, empty if reachable",
+		Computed:    true,
+	}
+	serverAttributes["stacks"] = schema.ListNestedAttribute{
+		Description: "Per-stack health",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Stack name",
+					Computed:    true,
+				},
+				"status": schema.StringAttribute{
+					Description: "Rolled-up status: 'running', 'degraded' or 'stopped'",
+					Computed:    true,
+				},
+				"is_healthy": schema.BoolAttribute{
+					Description: "Whether Berth reports the stack as healthy",
+					Computed:    true,
+				},
+				"running_containers": schema.Int64Attribute{
+					Description: "Number of running containers",
+					Computed:    true,
+				},
+				"total_containers": schema.Int64Attribute{
+					Description: "Total number of containers",
+					Computed:    true,
+				},
+			},
+		},
+	}
+
+	attributes := rollupAttributes("across the fleet")
+	attributes["id"] = schema.StringAttribute{
+		Description: "Data source identifier",
+		Computed:    true,
+	}
+	attributes["server_count"] = schema.Int64Attribute{
+		Description: "Number of registered servers",
+		Computed:    true,
+	}
+	attributes["unreachable_servers"] = schema.Int64Attribute{
+		Description: "Number of active servers whose stacks could not be listed",
+		Computed:    true,
+	}
+	attributes["servers"] = schema.ListNestedAttribute{
+		Description: "Per-server health rollups",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: serverAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Aggregates stack health across every server registered in Berth",
+		Attributes:  attributes,
+	}
+}
+
+func (d *FleetHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *FleetHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FleetHealthDataSourceModel
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+		return
+	}
+
+	var stackCount, running, degraded, stopped, unreachable int64
+
+	data.Servers = make([]FleetHealthServerModel, 0, len(servers))
+	for _, server := range servers {
+		serverHealth := FleetHealthServerModel{
+			ServerID:   types.Int64Value(int64(server.ID)),
+			ServerName: types.StringValue(server.Name),
+			IsActive:   types.BoolValue(server.IsActive),
+			Reachable:  types.BoolValue(false),
+			Error:      types.StringValue(""),
+			Stacks:     []FleetHealthStackModel{},
+		}
+
+		var serverRunning, serverDegraded, serverStopped int64
+
+		if server.IsActive {
+			stacks, err := d.client.ListStacks(ctx, server.ID)
+			if err != nil {
+				unreachable++
+				serverHealth.Error = types.StringValue(err.Error())
+			} else {
+				serverHealth.Reachable = types.BoolValue(true)
+				for _, stack := range stacks {
+					status := stackHealthStatus(stack)
+					switch status {
+					case stackStatusRunning:
+						serverRunning++
+					case stackStatusDegraded:
+						serverDegraded++
+					case stackStatusStopped:
+						serverStopped++
+					}
+
+					serverHealth.Stacks = append(serverHealth.Stacks, FleetHealthStackModel{
+						Name:              types.StringValue(stack.Name),
+						Status:            types.StringValue(status),
+						IsHealthy:         types.BoolValue(stack.IsHealthy),
+						RunningContainers: types.Int64Value(int64(stack.RunningContainers)),
+						TotalContainers:   types.Int64Value(int64(stack.TotalContainers)),
+					})
+				}
+			}
+		}
+
+		serverHealth.StackCount = types.Int64Value(int64(len(serverHealth.Stacks)))
+		serverHealth.Running = types.Int64Value(serverRunning)
+		serverHealth.Degraded = types.Int64Value(serverDegraded)
+		serverHealth.Stopped = types.Int64Value(serverStopped)
+
+		stackCount += int64(len(serverHealth.Stacks))
+		running += serverRunning
+		degraded += serverDegraded
+		stopped += serverStopped
+
+		data.Servers = append(data.Servers, serverHealth)
+	}
+
+	data.ID = types.StringValue("fleet_health")
+	data.ServerCount = types.Int64Value(int64(len(servers)))
+	data.UnreachableServers = types.Int64Value(unreachable)
+	data.StackCount = types.Int64Value(stackCount)
+	data.Running = types.Int64Value(running)
+	data.Degraded = types.Int64Value(degraded)
+	data.Stopped = types.Int64Value(stopped)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func stackHealthStatus(stack client.Stack) string {
+	switch {
+	case stack.RunningContainers == 0:
+		return stackStatusStopped
+	case stack.RunningContainers == stack.TotalContainers && stack.IsHealthy:
+		return stackStatusRunning
+	default:
+		return stackStatusDegraded
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+type resourceHarness struct {
+	t            *testing.T
+	resource     resource.Resource
+	schema       resource.SchemaResponse
+	providerData *ProviderData
+}
+
+func newTestClient(t *testing.T) (*berthtest.FakeServer, *client.Client) {
+	t.Helper()
+
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+
+	return fake, client.NewClient(client.Config{URL: fake.URL, APIKey: berthtest.APIKey})
+}
+
+func newProviderData(c client.BerthAPI) *ProviderData {
+	return &ProviderData{Client: c, RuleConcurrency: 4}
+}
+
+func newResourceHarness(t *testing.T, r resource.Resource, c client.BerthAPI) *resourceHarness {
+	t.Helper()
+	return newResourceHarnessWithData(t, r, newProviderData(c))
+}
+
+func newResourceHarnessWithData(t *testing.T, r resource.Resource, data *ProviderData) *resourceHarness {
+	t.Helper()
+	ctx := context.Background()
+
+	h := &resourceHarness{t: t, resource: r, providerData: data}
+	r.Schema(ctx, resource.SchemaRequest{}, &h.schema)
+	requireNoDiags(t, h.schema.Diagnostics)
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var resp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: data}, &resp)
+		requireNoDiags(t, resp.Diagnostics)
+	}
+
+	return h
+}
+
+func (h *resourceHarness) emptyState() tfsdk.State {
+	return tfsdk.State{
+		Schema: h.schema.Schema,
+		Raw:    tftypes.NewValue(h.schema.Schema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+func (h *resourceHarness) plan(model any) tfsdk.Plan {
+	h.t.Helper()
+
+	state := h.emptyState()
+	requireNoDiags(h.t, state.Set(context.Background(), model))
+	return tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+}
+
+func (h *resourceHarness) stateFrom(model any) tfsdk.State {
+	h.t.Helper()
+	return tfsdk.State(h.plan(model))
+}
+
+func (h *resourceHarness) modifyPlan(model any) diag.Diagnostics {
+	h.t.Helper()
+	return h.modifyPlanFrom(h.emptyState(), model)
+}
+
+func (h *resourceHarness) modifyPlanFrom(state tfsdk.State, model any) diag.Diagnostics {
+	h.t.Helper()
+
+	_, diags := h.modifiedPlanFrom(state, model)
+	return diags
+}
+
+func (h *resourceHarness) modifiedPlanFrom(state tfsdk.State, model any) (tfsdk.Plan, diag.Diagnostics) {
+	h.t.Helper()
+
+	modifier, ok := h.resource.(resource.ResourceWithModifyPlan)
+	if !ok {
+		h.t.Fatalf("resource does not support plan modification")
+	}
+
+	plan := tfsdk.Plan(h.emptyState())
+	if model != nil {
+		plan = h.plan(model)
+	}
+
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	modifier.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		Plan:   plan,
+		State:  state,
+	}, &resp)
+	return resp.Plan, resp.Diagnostics
+}
+
+func (h *resourceHarness) validateConfig(model any) diag.Diagnostics {
+	h.t.Helper()
+
+	validator, ok := h.resource.(resource.ResourceWithValidateConfig)
+	if !ok {
+		h.t.Fatalf("resource does not support config validation")
+	}
+
+	plan := h.plan(model)
+	var resp resource.ValidateConfigResponse
+	validator.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+	}, &resp)
+	return resp.Diagnostics
+}
+
+func (h *resourceHarness) create(model any) tfsdk.State {
+	h.t.Helper()
+
+	state, diags := h.tryCreate(model)
+	requireNoDiags(h.t, diags)
+	return state
+}
+
+func (h *resourceHarness) tryCreate(model any) (tfsdk.State, diag.Diagnostics) {
+	h.t.Helper()
+
+	plan := h.plan(model)
+	config := tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+
+	resp := resource.CreateResponse{State: h.emptyState()}
+	h.resource.Create(context.Background(), resource.CreateRequest{Config: config, Plan: plan}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) read(state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	resp := resource.ReadResponse{State: state}
+	h.resource.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) update(state tfsdk.State, model any) tfsdk.State {
+	h.t.Helper()
+
+	state, diags := h.tryUpdate(state, model)
+	requireNoDiags(h.t, diags)
+	return state
+}
+
+func (h *resourceHarness) tryUpdate(state tfsdk.State, model any) (tfsdk.State, diag.Diagnostics) {
+	h.t.Helper()
+
+	resp := resource.UpdateResponse{State: state}
+	h.resource.Update(context.Background(), resource.UpdateRequest{Plan: h.plan(model), State: state}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func (h *resourceHarness) delete(state tfsdk.State) {
+	h.t.Helper()
+	requireNoDiags(h.t, h.tryDelete(state))
+}
+
+func (h *resourceHarness) tryDelete(state tfsdk.State) diag.Diagnostics {
+	resp := resource.DeleteResponse{State: state}
+	h.resource.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	return resp.Diagnostics
+}
+
+func (h *resourceHarness) importState(id string) tfsdk.State {
+	h.t.Helper()
+
+	importer, ok := h.resource.(resource.ResourceWithImportState)
+	if !ok {
+		h.t.Fatalf("resource does not support import")
+	}
+
+	resp := resource.ImportStateResponse{State: h.emptyState()}
+	importer.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+	requireNoDiags(h.t, resp.Diagnostics)
+
+	state, diags := h.read(resp.State)
+	requireNoDiags(h.t, diags)
+	return state
+}
+
+func (h *resourceHarness) moveState(sourceTypeName string, source *resourceHarness, model any) (tfsdk.State, diag.Diagnostics) {
+	h.t.Helper()
+
+	mover, ok := h.resource.(resource.ResourceWithMoveState)
+	if !ok {
+		h.t.Fatalf("resource does not support moving state")
+	}
+
+	sourceState := source.stateFrom(model)
+	resp := resource.MoveStateResponse{TargetState: h.emptyState()}
+	for _, m := range mover.MoveState(context.Background()) {
+		m.StateMover(context.Background(), resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/tech-arch1tect/berth",
+			SourceTypeName:        sourceTypeName,
+			SourceState:           &sourceState,
+		}, &resp)
+	}
+	return resp.TargetState, resp.Diagnostics
+}
+
+func (h *resourceHarness) get(state tfsdk.State, target any) {
+	h.t.Helper()
+	requireNoDiags(h.t, state.Get(context.Background(), target))
+}
+
+func readDataSource(t *testing.T, d datasource.DataSource, c client.BerthAPI, model any) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	requireNoDiags(t, schemaResp.Diagnostics)
+
+	if configurable, ok := d.(datasource.DataSourceWithConfigure); ok {
+		var resp datasource.ConfigureResponse
+		configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: newProviderData(c)}, &resp)
+		requireNoDiags(t, resp.Diagnostics)
+	}
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	requireNoDiags(t, state.Set(ctx, model))
+
+	resp := datasource.ReadResponse{State: state}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	return resp.State, resp.Diagnostics
+}
+
+func requireNoDiags(t *testing.T, diags diag.Diagnostics) {
+	t.Helper()
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func requireDiagnostics(t *testing.T, diags diag.Diagnostics, wantError, wantWarning string) {
+	t.Helper()
+
+	var errors, warnings []string
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityError {
+			errors = append(errors, d.Summary())
+		} else {
+			warnings = append(warnings, d.Summary())
+		}
+	}
+
+	if (wantError == "" && len(errors) != 0) || (wantError != "" && (len(errors) != 1 || errors[0] != wantError)) {
+		t.Fatalf("expected error %q, got %v", wantError, diags)
+	}
+	if (wantWarning == "" && len(warnings) != 0) || (wantWarning != "" && (len(warnings) != 1 || warnings[0] != wantWarning)) {
+		t.Fatalf("expected warning %q, got %v", wantWarning, diags)
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var severityRanks = map[string]int{
+	"unknown":    0,
+	"negligible": 1,
+	"low":        2,
+	"medium":     3,
+	"high":       4,
+	"critical":   5,
+}
+
+var _ datasource.DataSource = &ImageVulnerabilitiesDataSource{}
+
+func NewImageVulnerabilitiesDataSource() datasource.DataSource {
+	return &ImageVulnerabilitiesDataSource{}
+}
+
+type ImageVulnerabilitiesDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type ImageVulnerabilitiesDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ServerID    types.Int64  `tfsdk:"server_id"`
+	StackName   types.String `tfsdk:"stack_name"`
+	ScanID      types.Int64  `tfsdk:"scan_id"`
+	Status      types.String `tfsdk:"status"`
+	CompletedAt types.String `tfsdk:"completed_at"`
+	VulnerabilityCountsModel
+	Images []ImageVulnerabilityCountsModel `tfsdk:"images"`
+}
+
+type VulnerabilityCountsModel struct {
+	Critical         types.Int64   `tfsdk:"critical"`
+	High             types.Int64   `tfsdk:"high"`
+	Medium           types.Int64   `tfsdk:"medium"`
+	Low              types.Int64   `tfsdk:"low"`
+	Negligible       types.Int64   `tfsdk:"negligible"`
+	Unknown          types.Int64   `tfsdk:"unknown"`
+	Total            types.Int64   `tfsdk:"total"`
+	WorstSeverity    types.String  `tfsdk:"worst_severity"`
+	WorstFinding     types.String  `tfsdk:"worst_finding"`
+	WorstFindingCVSS types.Float64 `tfsdk:"worst_finding_cvss"`
+}
+
+type ImageVulnerabilityCountsModel struct {
+	ImageName types.String `tfsdk:"image_name"`
+	VulnerabilityCountsModel
+}
+
+func (d *ImageVulnerabilitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image_vulnerabilities"
+}
+
+func (d *ImageVulnerabilitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	countAttributes := func(scope string) map[string]schema.Attribute {
+		attributes := map[string]schema.Attribute{
+			"total": schema.Int64Attribute{
+				Description: fmt.Sprintf("Total number of vulnerabilities %s", scope),
+				Computed:    true,
+			},
+			"worst_severity": schema.StringAttribute{
+				Description: fmt.Sprintf("Highest severity found %s, empty if none", scope),
+				Computed:    true,
+			},
+			"worst_finding": schema.StringAttribute{
+				Description: fmt.Sprintf("ID of the most severe vulnerability %s, empty if none", scope),
+				Computed:    true,
+			},
+			"worst_finding_cvss": schema.Float64Attribute{
+				Description: "CVSS score of the worst finding",
+				Computed:    true,
+			},
+		}
+		for severity := range severityRanks {
+			attributes[severity] = schema.Int64Attribute{
+				Description: fmt.Sprintf("Number of %s vulnerabilities %s", severity, scope),
+				Computed:    true,
+			}
+		}
+		return attributes
+	}
+
+	imageAttributes := countAttributes("in this image")
+	imageAttributes["image_name"] = schema.StringAttribute{
+		Description: "Image name",
+		Computed:    true,
+	}
+
+	attributes := countAttributes("across the stack")
+	attributes["id"] = schema.StringAttribute{
+		Description: "Identifier in format 'server_id:stack_name'",
+		Computed:    true,
+	}
+	attributes["server_id"] = schema.Int64Attribute{
+		Description: "Server ID",
+		Required:    true,
+	}
+	attributes["stack_name"] = schema.StringAttribute{
+		Description: "Stack name",
+		Required:    true,
+	}
+	attributes["scan_id"] = schema.Int64Attribute{
+		Description: "ID of the latest scan",
+		Computed:    true,
+	}
+	attributes["status"] = schema.StringAttribute{
+		Description: "Status of the latest scan",
+		Computed:    true,
+	}
+	attributes["completed_at"] = schema.StringAttribute{
+		Description: "Completion time of the latest scan (RFC3339), empty if still running",
+		Computed:    true,
+	}
+	attributes["images"] = schema.ListNestedAttribute{
+		Description: "Per-image vulnerability counts",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: imageAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Exposes the latest vulnerability scan results for a stack's images",
+		Attributes:  attributes,
+	}
+}
+
+func (d *ImageVulnerabilitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *ImageVulnerabilitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ImageVulnerabilitiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.StackName.ValueString()
+
+	scan, err := d.client.GetLatestStackScan(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read vulnerability scan", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	byImage := make(map[string][]client.ImageVulnerability)
+	for _, image := range scan.Images {
+		byImage[image] = nil
+	}
+	for _, v := range scan.Vulnerabilities {
+		byImage[v.ImageName] = append(byImage[v.ImageName], v)
+	}
+
+	images := make([]string, 0, len(byImage))
+	for image := range byImage {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+
+	data.Images = make([]ImageVulnerabilityCountsModel, 0, len(images))
+	for _, image := range images {
+		data.Images = append(data.Images, ImageVulnerabilityCountsModel{
+			ImageName:                types.StringValue(image),
+			VulnerabilityCountsModel: countVulnerabilities(byImage[image]),
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, stackName))
+	data.ScanID = types.Int64Value(int64(scan.ID))
+	data.Status = types.StringValue(scan.Status)
+	data.CompletedAt = types.StringValue(scan.CompletedAt)
+	data.VulnerabilityCountsModel = countVulnerabilities(scan.Vulnerabilities)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func countVulnerabilities(vulnerabilities []client.ImageVulnerability) VulnerabilityCountsModel {
+	counts := make(map[string]int64, len(severityRanks))
+	var worst *client.ImageVulnerability
+	var worstSeverity string
+
+	for i, v := range vulnerabilities {
+		severity := strings.ToLower(v.Severity)
+		if _, ok := severityRanks[severity]; !ok {
+			severity = "unknown"
+		}
+		counts[severity]++
+
+		rank, worstRank := severityRanks[severity], severityRanks[worstSeverity]
+		if worst == nil || rank > worstRank || (rank == worstRank && v.CVSS > worst.CVSS) {
+			worst = &vulnerabilities[i]
+			worstSeverity = severity
+		}
+	}
+
+	model := VulnerabilityCountsModel{
+		Critical:         types.Int64Value(counts["critical"]),
+		High:             types.Int64Value(counts["high"]),
+		Medium:           types.Int64Value(counts["medium"]),
+		Low:              types.Int64Value(counts["low"]),
+		Negligible:       types.Int64Value(counts["negligible"]),
+		Unknown:          types.Int64Value(counts["unknown"]),
+		Total:            types.Int64Value(int64(len(vulnerabilities))),
+		WorstSeverity:    types.StringValue(""),
+		WorstFinding:     types.StringValue(""),
+		WorstFindingCVSS: types.Float64Value(0),
+	}
+	if worst != nil {
+		model.WorstSeverity = types.StringValue(worstSeverity)
+		model.WorstFinding = types.StringValue(worst.VulnerabilityID)
+		model.WorstFindingCVSS = types.Float64Value(worst.CVSS)
+	}
+
+	return model
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+type mockBerthAPI struct {
+	client.BerthAPI
+
+	createRole           func(name, description string) (*client.Role, error)
+	getRole              func(id uint) (*client.Role, error)
+	updateRole           func(id uint, name, description string) (*client.Role, error)
+	deleteRole           func(id uint) error
+	getPermissionByName  func(name string) (*client.Permission, error)
+	listRolePermissions  func(roleID uint) ([]client.RolePermission, []client.Permission, error)
+	getRolePermission    func(roleID, permissionID uint) (*client.RolePermission, error)
+	createRolePermission func(roleID, serverID, permissionID uint, stackPattern string) (*client.RolePermission, error)
+	deleteRolePermission func(roleID, permissionID uint) error
+	listRoles            func() ([]client.Role, error)
+}
+
+var mockPermissions = []client.Permission{
+	{ID: 1, Name: "stacks.read"},
+	{ID: 2, Name: "stacks.manage"},
+	{ID: 3, Name: "logs.read"},
+}
+
+func mockServerError() error {
+	return &client.APIError{StatusCode: http.StatusInternalServerError, Message: "database unavailable"}
+}
+
+func mockPermissionByName(name string) (*client.Permission, error) {
+	for _, p := range mockPermissions {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, client.UnknownPermissionError(name, mockPermissions)
+}
+
+func (m *mockBerthAPI) CreateRole(ctx context.Context, name, description string) (*client.Role, error) {
+	return m.createRole(name, description)
+}
+
+func (m *mockBerthAPI) GetRole(ctx context.Context, id uint) (*client.Role, error) {
+	return m.getRole(id)
+}
+
+func (m *mockBerthAPI) UpdateRole(ctx context.Context, id uint, name, description string) (*client.Role, error) {
+	return m.updateRole(id, name, description)
+}
+
+func (m *mockBerthAPI) DeleteRole(ctx context.Context, id uint) error {
+	return m.deleteRole(id)
+}
+
+func (m *mockBerthAPI) GetPermissionByName(ctx context.Context, name string) (*client.Permission, error) {
+	if m.getPermissionByName == nil {
+		return mockPermissionByName(name)
+	}
+	return m.getPermissionByName(name)
+}
+
+func (m *mockBerthAPI) ListPermissions(ctx context.Context) ([]client.Permission, error) {
+	return mockPermissions, nil
+}
+
+func (m *mockBerthAPI) ListRolePermissions(ctx context.Context, roleID uint) ([]client.RolePermission, []client.Permission, error) {
+	if m.listRolePermissions == nil {
+		return nil, mockPermissions, nil
+	}
+	return m.listRolePermissions(roleID)
+}
+
+func (m *mockBerthAPI) GetRolePermission(ctx context.Context, roleID, permissionID uint) (*client.RolePermission, error) {
+	return m.getRolePermission(roleID, permissionID)
+}
+
+func (m *mockBerthAPI) CreateRolePermission(ctx context.Context, roleID, serverID, permissionID uint, stackPattern string) (*client.RolePermission, error) {
+	return m.createRolePermission(roleID, serverID, permissionID, stackPattern)
+}
+
+func (m *mockBerthAPI) DeleteRolePermission(ctx context.Context, roleID, permissionID uint) error {
+	return m.deleteRolePermission(roleID, permissionID)
+}
+
+func (m *mockBerthAPI) ListRoles(ctx context.Context) ([]client.Role, error) {
+	return m.listRoles()
+}
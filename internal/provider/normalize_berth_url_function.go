@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &NormalizeBerthURLFunction{}
+
+func NewNormalizeBerthURLFunction() function.Function {
+	return &NormalizeBerthURLFunction{}
+}
+
+type NormalizeBerthURLFunction struct{}
+
+func (f *NormalizeBerthURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_berth_url"
+}
+
+func (f *NormalizeBerthURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validate and normalize a Berth base URL",
+		Description: "Returns the URL with a lowercase scheme and host, default ports removed, a cleaned path prefix and no trailing slash. A missing scheme defaults to https. Fails on unsupported schemes, credentials, query strings or fragments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "url",
+				Description: "Berth base URL (e.g., 'https://berth.example.com/')",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NormalizeBerthURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rawURL string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rawURL))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeBerthURL(rawURL)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
+
+func normalizeBerthURL(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", fmt.Errorf("URL must not be empty")
+	}
+
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %s", rawURL, err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", fmt.Errorf("unsupported URL scheme %q, expected http or https", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("URL %q has no host", rawURL)
+	}
+	if u.User != nil {
+		return "", fmt.Errorf("URL must not contain credentials, configure them in the provider's authentication settings instead")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("URL must not contain a query string or fragment")
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" && !(scheme == "https" && port == "443") && !(scheme == "http" && port == "80") {
+		host = host + ":" + port
+	}
+
+	prefix := ""
+	if u.Path != "" && u.Path != "/" {
+		prefix = strings.TrimSuffix(path.Clean("/"+u.Path), "/")
+	}
+
+	return scheme + "://" + host + prefix, nil
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &OperationLogDataSource{}
+
+func NewOperationLogDataSource() datasource.DataSource {
+	return &OperationLogDataSource{}
+}
+
+type OperationLogDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type OperationLogDataSourceModel struct {
+	ID         types.String             `tfsdk:"id"`
+	ServerID   types.Int64              `tfsdk:"server_id"`
+	StackName  types.String             `tfsdk:"stack_name"`
+	Command    types.String             `tfsdk:"command"`
+	Status     types.String             `tfsdk:"status"`
+	Search     types.String             `tfsdk:"search"`
+	DaysBack   types.Int64              `tfsdk:"days_back"`
+	Limit      types.Int64              `tfsdk:"limit"`
+	Operations []OperationLogEntryModel `tfsdk:"operations"`
+}
+
+type OperationLogEntryModel struct {
+	ID            types.String `tfsdk:"id"`
+	OperationID   types.String `tfsdk:"operation_id"`
+	ServerID      types.Int64  `tfsdk:"server_id"`
+	ServerName    types.String `tfsdk:"server_name"`
+	StackName     types.String `tfsdk:"stack_name"`
+	Command       types.String `tfsdk:"command"`
+	UserName      types.String `tfsdk:"user_name"`
+	TriggerSource types.String `tfsdk:"trigger_source"`
+	Status        types.String `tfsdk:"status"`
+	Success       types.Bool   `tfsdk:"success"`
+	ExitCode      types.Int64  `tfsdk:"exit_code"`
+	StartTime     types.String `tfsdk:"start_time"`
+	EndTime       types.String `tfsdk:"end_time"`
+	DurationMs    types.Int64  `tfsdk:"duration_ms"`
+}
+
+func (d *OperationLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operation_log"
+}
+
+func (d *OperationLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the history of stack operations (up, down, pull, ...) across all users",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Only return operations on this server",
+				Optional:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Only return operations on this stack",
+				Optional:    true,
+			},
+			"command": schema.StringAttribute{
+				Description: "Only return operations running this command (e.g., 'up', 'down', 'pull')",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only return operations with this status",
+				Optional:    true,
+			},
+			"search": schema.StringAttribute{
+				Description: "Free-text search across operation logs",
+				Optional:    true,
+			},
+			"days_back": schema.Int64Attribute{
+				Description: "Only return operations from the last N days",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of operations to return (most recent first). Defaults to 100",
+				Optional:    true,
+			},
+			"operations": schema.ListNestedAttribute{
+				Description: "Matching operations",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Operation log ID",
+							Computed:    true,
+						},
+						"operation_id": schema.StringAttribute{
+							Description: "Operation UUID",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID",
+							Computed:    true,
+						},
+						"server_name": schema.StringAttribute{
+							Description: "Server name",
+							Computed:    true,
+						},
+						"stack_name": schema.StringAttribute{
+							Description: "Stack name",
+							Computed:    true,
+						},
+						"command": schema.StringAttribute{
+							Description: "Command that was run",
+							Computed:    true,
+						},
+						"user_name": schema.StringAttribute{
+							Description: "User who ran the operation",
+							Computed:    true,
+						},
+						"trigger_source": schema.StringAttribute{
+							Description: "What triggered the operation (e.g., UI, API)",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Operation status",
+							Computed:    true,
+						},
+						"success": schema.BoolAttribute{
+							Description: "Whether the operation succeeded, null while running",
+							Computed:    true,
+						},
+						"exit_code": schema.Int64Attribute{
+							Description: "Exit code, null while running",
+							Computed:    true,
+						},
+						"start_time": schema.StringAttribute{
+							Description: "Start time (RFC 3339)",
+							Computed:    true,
+						},
+						"end_time": schema.StringAttribute{
+							Description: "End time (RFC 3339)",
+							Computed:    true,
+						},
+						"duration_ms": schema.Int64Attribute{
+							Description: "Duration in milliseconds, null while running",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OperationLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *OperationLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OperationLogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	limit := 100
+	if !data.Limit.IsNull() {
+		limit = int(data.Limit.ValueInt64())
+	}
+
+	logs, err := d.client.ListOperationLogs(ctx, client.OperationLogFilter{
+		ServerID:  uint(data.ServerID.ValueInt64()),
+		StackName: data.StackName.ValueString(),
+		Command:   data.Command.ValueString(),
+		Status:    data.Status.ValueString(),
+		Search:    data.Search.ValueString(),
+		DaysBack:  int(data.DaysBack.ValueInt64()),
+		Limit:     limit,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list operation logs", apiErrorDetail(d.providerData, "Operation logs", err))
+		return
+	}
+
+	data.Operations = make([]OperationLogEntryModel, 0, len(logs))
+	for _, l := range logs {
+		entry := OperationLogEntryModel{
+			ID:            types.StringValue(strconv.FormatUint(uint64(l.ID), 10)),
+			OperationID:   types.StringValue(l.OperationID),
+			ServerID:      types.Int64Value(int64(l.ServerID)),
+			ServerName:    types.StringValue(l.ServerName),
+			StackName:     types.StringValue(l.StackName),
+			Command:       types.StringValue(l.Command),
+			UserName:      types.StringValue(l.UserName),
+			TriggerSource: types.StringValue(l.TriggerSource),
+			Status:        types.StringValue(l.Status),
+			Success:       types.BoolNull(),
+			ExitCode:      types.Int64Null(),
+			StartTime:     types.StringValue(l.StartTime),
+			EndTime:       types.StringValue(l.EndTime),
+			DurationMs:    types.Int64Null(),
+		}
+		if l.Success != nil {
+			entry.Success = types.BoolValue(*l.Success)
+		}
+		if l.ExitCode != nil {
+			entry.ExitCode = types.Int64Value(int64(*l.ExitCode))
+		}
+		if l.DurationMs != nil {
+			entry.DurationMs = types.Int64Value(int64(*l.DurationMs))
+		}
+		data.Operations = append(data.Operations, entry)
+	}
+
+	data.ID = types.StringValue("operation_log")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
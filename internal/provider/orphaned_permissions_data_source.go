@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const (
+	orphanReasonMissingServer     = "missing_server"
+	orphanReasonMissingPermission = "missing_permission"
+)
+
+var _ datasource.DataSource = &OrphanedPermissionsDataSource{}
+
+func NewOrphanedPermissionsDataSource() datasource.DataSource {
+	return &OrphanedPermissionsDataSource{}
+}
+
+type OrphanedPermissionsDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type OrphanedPermissionsDataSourceModel struct {
+	ID    types.String              `tfsdk:"id"`
+	Rules []OrphanedPermissionModel `tfsdk:"rules"`
+}
+
+type OrphanedPermissionModel struct {
+	ID           types.String `tfsdk:"id"`
+	RoleID       types.Int64  `tfsdk:"role_id"`
+	RoleName     types.String `tfsdk:"role_name"`
+	ServerID     types.Int64  `tfsdk:"server_id"`
+	PermissionID types.Int64  `tfsdk:"permission_id"`
+	StackPattern types.String `tfsdk:"stack_pattern"`
+	Reason       types.String `tfsdk:"reason"`
+}
+
+func (d *OrphanedPermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orphaned_permissions"
+}
+
+func (d *OrphanedPermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns role permission rules that reference servers or permissions that no longer exist",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Orphaned permission rules",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Permission rule ID",
+							Computed:    true,
+						},
+						"role_id": schema.Int64Attribute{
+							Description: "Role ID",
+							Computed:    true,
+						},
+						"role_name": schema.StringAttribute{
+							Description: "Role name",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID referenced by the rule",
+							Computed:    true,
+						},
+						"permission_id": schema.Int64Attribute{
+							Description: "Permission ID referenced by the rule",
+							Computed:    true,
+						},
+						"stack_pattern": schema.StringAttribute{
+							Description: "Stack name pattern",
+							Computed:    true,
+						},
+						"reason": schema.StringAttribute{
+							Description: "Why the rule is orphaned: 'missing_server' or 'missing_permission'",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrphanedPermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *OrphanedPermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrphanedPermissionsDataSourceModel
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+		return
+	}
+
+	serverIDs := make(map[uint]bool, len(servers))
+	for _, s := range servers {
+		serverIDs[s.ID] = true
+	}
+
+	permissions, err := d.client.ListPermissions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list permissions", apiErrorDetail(d.providerData, "Permissions", err))
+		return
+	}
+
+	permissionIDs := make(map[uint]bool, len(permissions))
+	for _, p := range permissions {
+		permissionIDs[p.ID] = true
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list roles", apiErrorDetail(d.providerData, "Roles", err))
+		return
+	}
+
+	data.Rules = make([]OrphanedPermissionModel, 0)
+	for _, role := range roles {
+		perms, _, err := d.client.ListRolePermissions(ctx, role.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", role.Name), err))
+			return
+		}
+
+		for _, perm := range perms {
+			var reason string
+			switch {
+			case perm.ServerID != 0 && !serverIDs[perm.ServerID]:
+				reason = orphanReasonMissingServer
+			case !permissionIDs[perm.PermissionID]:
+				reason = orphanReasonMissingPermission
+			default:
+				continue
+			}
+
+			data.Rules = append(data.Rules, OrphanedPermissionModel{
+				ID:           types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+				RoleID:       types.Int64Value(int64(role.ID)),
+				RoleName:     types.StringValue(role.Name),
+				ServerID:     types.Int64Value(int64(perm.ServerID)),
+				PermissionID: types.Int64Value(int64(perm.PermissionID)),
+				StackPattern: types.StringValue(perm.StackPattern),
+				Reason:       types.StringValue(reason),
+			})
+		}
+	}
+
+	data.ID = types.StringValue("orphaned_permissions")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOrphanedPermissionsDataSource(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	deploy := fake.AddRole("deploy", "")
+	fake.AddRule(deploy.ID, server.ID, "stacks.read", "*")
+	missingServer := fake.AddRule(deploy.ID, 999, "stacks.manage", "app-*")
+	fake.AddRule(deploy.ID, 0, "stacks.read", "*")
+	viewers := fake.AddRole("viewers", "")
+	missingPermission := fake.AddRule(viewers.ID, server.ID, "logs.read", "web")
+	fake.AddRule(viewers.ID, 998, "logs.read", "*")
+	fake.RemovePermission("logs.read")
+	fake.AddRole("empty", "")
+
+	state, diags := readDataSource(t, NewOrphanedPermissionsDataSource(), c, OrphanedPermissionsDataSourceModel{
+		ID: types.StringNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data OrphanedPermissionsDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if len(data.Rules) != 3 {
+		t.Fatalf("expected 3 orphaned rules, got %+v", data.Rules)
+	}
+
+	got := map[string]OrphanedPermissionModel{}
+	for _, rule := range data.Rules {
+		got[rule.ID.ValueString()] = rule
+	}
+
+	serverRule := got[strconv.Itoa(int(missingServer.ID))]
+	if serverRule.Reason.ValueString() != orphanReasonMissingServer || serverRule.RoleName.ValueString() != "deploy" || serverRule.ServerID.ValueInt64() != 999 || serverRule.StackPattern.ValueString() != "app-*" {
+		t.Fatalf("unexpected missing server rule: %+v", serverRule)
+	}
+
+	permissionRule := got[strconv.Itoa(int(missingPermission.ID))]
+	if permissionRule.Reason.ValueString() != orphanReasonMissingPermission || permissionRule.RoleID.ValueInt64() != int64(viewers.ID) || permissionRule.PermissionID.ValueInt64() != int64(missingPermission.PermissionID) {
+		t.Fatalf("unexpected missing permission rule: %+v", permissionRule)
+	}
+
+	reasons := map[string]int{}
+	for _, rule := range data.Rules {
+		reasons[rule.Reason.ValueString()]++
+	}
+	if reasons[orphanReasonMissingServer] != 2 || reasons[orphanReasonMissingPermission] != 1 {
+		t.Fatalf("expected a missing server to take precedence over a missing permission, got %v", reasons)
+	}
+}
+
+func TestOrphanedPermissionsDataSource_NoOrphans(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deploy", "")
+	fake.AddRule(role.ID, server.ID, "stacks.read", "*")
+
+	state, diags := readDataSource(t, NewOrphanedPermissionsDataSource(), c, OrphanedPermissionsDataSourceModel{
+		ID: types.StringNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data OrphanedPermissionsDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.Rules == nil || len(data.Rules) != 0 {
+		t.Fatalf("expected an empty rule list, got %+v", data.Rules)
+	}
+}
+
+func TestOrphanedPermissionsDataSource_ListError(t *testing.T) {
+	fake, c := newTestClient(t)
+	fake.DisableEndpoint(http.MethodGet, "/api/v1/admin/roles")
+
+	_, diags := readDataSource(t, NewOrphanedPermissionsDataSource(), c, OrphanedPermissionsDataSourceModel{
+		ID: types.StringNull(),
+	})
+	requireDiagnostics(t, diags, "Failed to list roles", "")
+}
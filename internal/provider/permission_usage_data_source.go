@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &PermissionUsageDataSource{}
+
+func NewPermissionUsageDataSource() datasource.DataSource {
+	return &PermissionUsageDataSource{}
+}
+
+type PermissionUsageDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type PermissionUsageDataSourceModel struct {
+	ID             types.String               `tfsdk:"id"`
+	PermissionName types.String               `tfsdk:"permission_name"`
+	PermissionID   types.Int64                `tfsdk:"permission_id"`
+	RoleIDs        []types.Int64              `tfsdk:"role_ids"`
+	Rules          []PermissionUsageRuleModel `tfsdk:"rules"`
+}
+
+type PermissionUsageRuleModel struct {
+	ID           types.String `tfsdk:"id"`
+	RoleID       types.Int64  `tfsdk:"role_id"`
+	RoleName     types.String `tfsdk:"role_name"`
+	ServerID     types.Int64  `tfsdk:"server_id"`
+	StackPattern types.String `tfsdk:"stack_pattern"`
+}
+
+func (d *PermissionUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_usage"
+}
+
+func (d *PermissionUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns every role and permission rule that references a given permission",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"permission_name": schema.StringAttribute{
+				Description: "Permission name (e.g., 'stacks.read', 'files.write')",
+				Required:    true,
+			},
+			"permission_id": schema.Int64Attribute{
+				Description: "Permission ID",
+				Computed:    true,
+			},
+			"role_ids": schema.ListAttribute{
+				Description: "IDs of roles with at least one rule using the permission",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Permission rules using the permission",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Permission rule ID",
+							Computed:    true,
+						},
+						"role_id": schema.Int64Attribute{
+							Description: "Role ID",
+							Computed:    true,
+						},
+						"role_name": schema.StringAttribute{
+							Description: "Role name",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID",
+							Computed:    true,
+						},
+						"stack_pattern": schema.StringAttribute{
+							Description: "Stack name pattern",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *PermissionUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := d.client.GetPermissionByName(ctx, data.PermissionName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find permission", apiErrorDetail(d.providerData, fmt.Sprintf("Permission %q", data.PermissionName.ValueString()), err))
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list roles", apiErrorDetail(d.providerData, "Roles", err))
+		return
+	}
+
+	data.RoleIDs = make([]types.Int64, 0)
+	data.Rules = make([]PermissionUsageRuleModel, 0)
+	for _, role := range roles {
+		perms, _, err := d.client.ListRolePermissions(ctx, role.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", role.Name), err))
+			return
+		}
+
+		used := false
+		for _, perm := range perms {
+			if perm.PermissionID != permission.ID {
+				continue
+			}
+
+			used = true
+			data.Rules = append(data.Rules, PermissionUsageRuleModel{
+				ID:           types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+				RoleID:       types.Int64Value(int64(role.ID)),
+				RoleName:     types.StringValue(role.Name),
+				ServerID:     types.Int64Value(int64(perm.ServerID)),
+				StackPattern: types.StringValue(perm.StackPattern),
+			})
+		}
+
+		if used {
+			data.RoleIDs = append(data.RoleIDs, types.Int64Value(int64(role.ID)))
+		}
+	}
+
+	data.ID = types.StringValue(permission.Name)
+	data.PermissionID = types.Int64Value(int64(permission.ID))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func permissionUsageModel(name string) PermissionUsageDataSourceModel {
+	return PermissionUsageDataSourceModel{
+		ID:             types.StringNull(),
+		PermissionName: types.StringValue(name),
+		PermissionID:   types.Int64Null(),
+	}
+}
+
+func TestPermissionUsageDataSource(t *testing.T) {
+	fake, c := newTestClient(t)
+	prod := fake.AddServer("prod", "10.0.0.1", 8081)
+	staging := fake.AddServer("staging", "10.0.0.2", 8081)
+	deploy := fake.AddRole("deploy", "")
+	first := fake.AddRule(deploy.ID, prod.ID, "stacks.manage", "app-*")
+	fake.AddRule(deploy.ID, prod.ID, "stacks.read", "*")
+	second := fake.AddRule(deploy.ID, staging.ID, "stacks.manage", "*")
+	viewers := fake.AddRole("viewers", "")
+	fake.AddRule(viewers.ID, prod.ID, "stacks.read", "*")
+	ops := fake.AddRole("ops", "")
+	third := fake.AddRule(ops.ID, staging.ID, "stacks.manage", "db")
+
+	state, diags := readDataSource(t, NewPermissionUsageDataSource(), c, permissionUsageModel("stacks.manage"))
+	requireNoDiags(t, diags)
+
+	var data PermissionUsageDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.ID.ValueString() != "stacks.manage" || data.PermissionID.IsNull() {
+		t.Fatalf("unexpected permission: %s, %s", data.ID, data.PermissionID)
+	}
+	if len(data.RoleIDs) != 2 || data.RoleIDs[0].ValueInt64() != int64(deploy.ID) || data.RoleIDs[1].ValueInt64() != int64(ops.ID) {
+		t.Fatalf("expected each using role once, got %v", data.RoleIDs)
+	}
+
+	if len(data.Rules) != 3 {
+		t.Fatalf("expected 3 rules, got %+v", data.Rules)
+	}
+	for i, want := range []struct {
+		id, roleID, serverID int32
+		roleName, pattern    string
+	}{
+		{first.ID, deploy.ID, prod.ID, "deploy", "app-*"},
+		{second.ID, deploy.ID, staging.ID, "deploy", "*"},
+		{third.ID, ops.ID, staging.ID, "ops", "db"},
+	} {
+		got := data.Rules[i]
+		if got.ID.ValueString() != strconv.Itoa(int(want.id)) || got.RoleID.ValueInt64() != int64(want.roleID) || got.RoleName.ValueString() != want.roleName ||
+			got.ServerID.ValueInt64() != int64(want.serverID) || got.StackPattern.ValueString() != want.pattern {
+			t.Fatalf("rule %d: unexpected %+v", i, got)
+		}
+	}
+}
+
+func TestPermissionUsageDataSource_Unused(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("viewers", "")
+	fake.AddRule(role.ID, server.ID, "stacks.read", "*")
+
+	state, diags := readDataSource(t, NewPermissionUsageDataSource(), c, permissionUsageModel("logs.read"))
+	requireNoDiags(t, diags)
+
+	var data PermissionUsageDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.RoleIDs == nil || len(data.RoleIDs) != 0 || data.Rules == nil || len(data.Rules) != 0 {
+		t.Fatalf("expected empty lists, got %+v", data)
+	}
+}
+
+func TestPermissionUsageDataSource_UnknownPermission(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, diags := readDataSource(t, NewPermissionUsageDataSource(), c, permissionUsageModel("stacks.raed"))
+	requireDiagnostics(t, diags, "Failed to find permission", "")
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var knownPermissionNames = []string{
+	"stacks.read",
+	"stacks.manage",
+	"stacks.create",
+	"files.read",
+	"files.write",
+	"logs.read",
+}
+
+var _ validator.String = permissionNameValidator{}
+
+type permissionNameValidator struct{}
+
+func (v permissionNameValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value should be one of the Berth permissions: %s", strings.Join(knownPermissionNames, ", "))
+}
+
+func (v permissionNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v permissionNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if name == "" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid permission name", "Permission name must not be empty")
+		return
+	}
+
+	if slices.Contains(knownPermissionNames, name) {
+		return
+	}
+
+	permissions := make([]client.Permission, 0, len(knownPermissionNames))
+	for _, known := range knownPermissionNames {
+		permissions = append(permissions, client.Permission{Name: known})
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		req.Path,
+		"Unrecognized permission name",
+		fmt.Sprintf("The %s. It may only exist on a newer Berth release; the name is checked against the server's permission catalog when planning.", client.UnknownPermissionError(name, permissions)),
+	)
+}
@@ -1,9 +1,23 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,15 +26,56 @@ import (
 )
 
 var _ provider.Provider = &BerthProvider{}
+var _ provider.ProviderWithFunctions = &BerthProvider{}
 
 type BerthProvider struct {
 	version string
 }
 
 type BerthProviderModel struct {
-	URL                types.String `tfsdk:"url"`
-	APIKey             types.String `tfsdk:"api_key"`
-	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	URL                     types.String    `tfsdk:"url"`
+	APIKey                  types.String    `tfsdk:"api_key"`
+	APIKeyFile              types.String    `tfsdk:"api_key_file"`
+	APIKeyCommand           types.List      `tfsdk:"api_key_command"`
+	OAuth                   *OAuthModel     `tfsdk:"oauth"`
+	InsecureSkipVerify      types.Bool      `tfsdk:"insecure_skip_verify"`
+	CACertPEM               types.String    `tfsdk:"ca_cert_pem"`
+	CACertFile              types.String    `tfsdk:"ca_cert_file"`
+	ProxyURL                types.String    `tfsdk:"proxy_url"`
+	MaxConcurrentOperations types.Int64     `tfsdk:"max_concurrent_operations"`
+	RuleConcurrency         types.Int64     `tfsdk:"rule_concurrency"`
+	ValidateReferences      types.Bool      `tfsdk:"validate_references"`
+	RateLimit               *RateLimitModel `tfsdk:"rate_limit"`
+	HTTP2                   types.String    `tfsdk:"http2"`
+	TLSSessionResumption    types.Bool      `tfsdk:"tls_session_resumption"`
+	DisableKeepAlives       types.Bool      `tfsdk:"disable_keep_alives"`
+	Headers                 types.Map       `tfsdk:"headers"`
+	MaxRetries              types.Int64     `tfsdk:"max_retries"`
+	RetryWaitMin            types.String    `tfsdk:"retry_wait_min"`
+	RetryWaitMax            types.String    `tfsdk:"retry_wait_max"`
+}
+
+type OAuthModel struct {
+	TokenURL     types.String `tfsdk:"token_url"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Scopes       types.List   `tfsdk:"scopes"`
+}
+
+type RateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
+}
+
+type ProviderData struct {
+	Client             client.BerthAPI
+	RuleConcurrency    int
+	ValidateReferences bool
+	AuthMethod         string
+
+	capabilitiesMu sync.Mutex
+	features       map[client.Feature]bool
+	version        string
 }
 
 func New(version string) func() provider.Provider {
@@ -41,16 +96,116 @@ func (p *BerthProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		Description: "Terraform provider for managing Berth roles and permissions",
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				Description: "Berth server URL (e.g., https://berth.example.com)",
-				Required:    true,
+				Description: "Berth server URL (e.g., https://berth.example.com). Can also be set with the BERTH_URL environment variable",
+				Optional:    true,
 			},
 			"api_key": schema.StringAttribute{
-				Description: "Berth API key (must have admin privileges)",
-				Required:    true,
+				Description: "Berth API key (must have admin privileges). Can also be set with the BERTH_API_KEY environment variable. Conflicts with api_key_file, api_key_command and oauth",
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key_file": schema.StringAttribute{
+				Description: "Path to a file containing the Berth API key. Leading and trailing whitespace is ignored. Conflicts with api_key, api_key_command and oauth",
+				Optional:    true,
+			},
+			"api_key_command": schema.ListAttribute{
+				Description: "Command and arguments executed at configure time whose standard output is the Berth API key, e.g. [\"vault\", \"kv\", \"get\", \"-field=api_key\", \"secret/berth\"]. The command is run directly, not through a shell. Conflicts with api_key, api_key_file and oauth",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"oauth": schema.SingleNestedAttribute{
+				Description: "Authenticate with bearer tokens obtained from an OAuth2 token endpoint using the client credentials grant, instead of an API key. Tokens are cached and refreshed automatically before they expire. Conflicts with api_key, api_key_file and api_key_command",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"token_url": schema.StringAttribute{
+						Description: "URL of the OAuth2 token endpoint",
+						Required:    true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "OAuth2 client ID",
+						Required:    true,
+					},
+					"client_secret": schema.StringAttribute{
+						Description: "OAuth2 client secret",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"scopes": schema.ListAttribute{
+						Description: "Scopes to request with the token",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
 			"insecure_skip_verify": schema.BoolAttribute{
-				Description: "Skip TLS certificate verification",
+				Description: "Skip TLS certificate verification. Can also be set with the BERTH_INSECURE_SKIP_VERIFY environment variable",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to trust in addition to the system roots. Conflicts with ca_cert_file",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file containing PEM-encoded CA certificates to trust in addition to the system roots. Conflicts with ca_cert_pem",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an HTTP, HTTPS or SOCKS5 proxy used to reach Berth (e.g., http://proxy.example.com:3128). When unset, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are honored",
+				Optional:    true,
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Description: "Maximum number of create/update/delete requests sent to Berth at the same time, independent of Terraform's -parallelism. Unlimited if unset",
+				Optional:    true,
+			},
+			"rule_concurrency": schema.Int64Attribute{
+				Description: "Number of permission rules a berth_role creates or deletes in parallel. Requests are still subject to max_concurrent_operations and rate_limit. Defaults to 4",
+				Optional:    true,
+			},
+			"validate_references": schema.BoolAttribute{
+				Description: "Check during plan that the servers referenced by berth_role and berth_role_permission exist, instead of failing during apply. Costs one extra request per resource. Defaults to false",
+				Optional:    true,
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Description: "Client-side limit on the rate of requests sent to Berth, applied to every request including retries. Unlimited if unset",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Description: "Sustained number of requests per second",
+						Required:    true,
+					},
+					"burst": schema.Int64Attribute{
+						Description: "Number of requests that can be sent at once before the rate applies. Defaults to 1",
+						Optional:    true,
+					},
+				},
+			},
+			"http2": schema.StringAttribute{
+				Description: "HTTP/2 usage: 'disabled' (HTTP/1.1 only), 'enabled' (negotiate HTTP/2 when the server supports it) or 'required' (HTTP/2 only). Defaults to 'disabled'",
+				Optional:    true,
+			},
+			"tls_session_resumption": schema.BoolAttribute{
+				Description: "Cache TLS sessions so reconnects can resume them instead of performing a full handshake",
+				Optional:    true,
+			},
+			"disable_keep_alives": schema.BoolAttribute{
+				Description: "Open a new connection for every request instead of reusing idle connections",
+				Optional:    true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent with every request, e.g. a tenant header required by a gateway in front of Berth. Cannot override Authorization or User-Agent",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times an idempotent request is retried after a connection error or a 429, 502, 503 or 504 response. Set to 0 to disable retries. Defaults to 3",
+				Optional:    true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "Minimum time to wait before retrying a request, as a duration such as '500ms' or '1s'. The wait doubles with every attempt and is jittered. Defaults to '1s'",
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "Maximum time to wait before retrying a request, as a duration such as '30s'. Also caps the wait requested by a Retry-After header. Defaults to '30s'",
 				Optional:    true,
 			},
 		},
@@ -65,28 +220,455 @@ func (p *BerthProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
+	baseURL := os.Getenv("BERTH_URL")
+	if !config.URL.IsNull() {
+		baseURL = config.URL.ValueString()
+	}
+
+	apiKey := os.Getenv("BERTH_API_KEY")
+	if !config.APIKey.IsNull() {
+		apiKey = config.APIKey.ValueString()
+	}
+
+	if baseURL == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing Berth URL",
+			"The provider requires a Berth server URL. Set the url attribute in the provider configuration or the BERTH_URL environment variable.",
+		)
+	}
+
+	authMethods := 0
+	for _, set := range []bool{!config.APIKey.IsNull(), !config.APIKeyFile.IsNull(), !config.APIKeyCommand.IsNull(), config.OAuth != nil} {
+		if set {
+			authMethods++
+		}
+	}
+	if authMethods > 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Conflicting authentication",
+			"Only one of api_key, api_key_file, api_key_command and oauth can be set.",
+		)
+	}
+
+	var oauth *client.OAuthConfig
+	authMethod := authAPIKey
+	switch {
+	case config.OAuth != nil:
+		var diags diag.Diagnostics
+		oauth, diags = parseOAuthConfig(ctx, config.OAuth)
+		resp.Diagnostics.Append(diags...)
+		apiKey = ""
+		authMethod = authOAuth
+	case !config.APIKeyFile.IsNull():
+		var diags diag.Diagnostics
+		apiKey, diags = readAPIKeyFile(config.APIKeyFile.ValueString())
+		resp.Diagnostics.Append(diags...)
+		authMethod = authAPIKeyFile
+	case !config.APIKeyCommand.IsNull():
+		var diags diag.Diagnostics
+		apiKey, diags = runAPIKeyCommand(ctx, config.APIKeyCommand)
+		resp.Diagnostics.Append(diags...)
+		authMethod = authAPIKeyCommand
+	case apiKey == "":
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Berth API key",
+			"The provider requires a Berth API key. Set the api_key, api_key_file or api_key_command attribute in the provider configuration or the BERTH_API_KEY environment variable, or configure oauth.",
+		)
+	}
+
 	insecureSkipVerify := false
 	if !config.InsecureSkipVerify.IsNull() {
 		insecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("BERTH_INSECURE_SKIP_VERIFY"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid BERTH_INSECURE_SKIP_VERIFY",
+				fmt.Sprintf("BERTH_INSECURE_SKIP_VERIFY must be a boolean, got: %q", v),
+			)
+		}
+		insecureSkipVerify = parsed
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	headers := make(map[string]string)
+	if !config.Headers.IsNull() {
+		resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for name := range headers {
+			switch http.CanonicalHeaderKey(name) {
+			case "Authorization", "User-Agent":
+				resp.Diagnostics.AddAttributeError(
+					path.Root("headers"),
+					"Invalid headers",
+					fmt.Sprintf("The %s header is managed by the provider and cannot be set in headers", name),
+				)
+				return
+			}
+		}
+	}
+
+	rootCAs, diags := loadRootCAs(config.CACertPEM, config.CACertFile)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	proxyURL, diags := parseProxyURL(config.ProxyURL)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxConcurrentOperations := 0
+	if !config.MaxConcurrentOperations.IsNull() {
+		maxConcurrentOperations = int(config.MaxConcurrentOperations.ValueInt64())
+		if maxConcurrentOperations < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_concurrent_operations"),
+				"Invalid max_concurrent_operations",
+				"max_concurrent_operations must be at least 1",
+			)
+			return
+		}
+	}
+
+	var rateLimit float64
+	rateLimitBurst := 1
+	if config.RateLimit != nil {
+		rateLimit = config.RateLimit.RequestsPerSecond.ValueFloat64()
+		if rateLimit <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rate_limit").AtName("requests_per_second"),
+				"Invalid requests_per_second",
+				"requests_per_second must be greater than 0",
+			)
+			return
+		}
+
+		if !config.RateLimit.Burst.IsNull() {
+			rateLimitBurst = int(config.RateLimit.Burst.ValueInt64())
+			if rateLimitBurst < 1 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("rate_limit").AtName("burst"),
+					"Invalid burst",
+					"burst must be at least 1",
+				)
+				return
+			}
+		}
 	}
 
-	client := client.NewClient(
-		config.URL.ValueString(),
-		config.APIKey.ValueString(),
-		insecureSkipVerify,
-	)
+	http2 := client.HTTP2Disabled
+	if !config.HTTP2.IsNull() {
+		http2 = config.HTTP2.ValueString()
+		switch http2 {
+		case client.HTTP2Disabled, client.HTTP2Enabled, client.HTTP2Required:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http2"),
+				"Invalid http2",
+				fmt.Sprintf("http2 must be one of 'disabled', 'enabled' or 'required', got: %q", http2),
+			)
+			return
+		}
+	}
+
+	ruleConcurrency := 4
+	if !config.RuleConcurrency.IsNull() {
+		ruleConcurrency = int(config.RuleConcurrency.ValueInt64())
+		if ruleConcurrency < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule_concurrency"),
+				"Invalid rule_concurrency",
+				"rule_concurrency must be at least 1",
+			)
+			return
+		}
+	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	maxRetries := 3
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid max_retries",
+				"max_retries must not be negative",
+			)
+			return
+		}
+	}
+
+	retryWaitMin, diags := parseDurationAttribute(config.RetryWaitMin, path.Root("retry_wait_min"), time.Second)
+	resp.Diagnostics.Append(diags...)
+	retryWaitMax, diags := parseDurationAttribute(config.RetryWaitMax, path.Root("retry_wait_max"), 30*time.Second)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid retry_wait_min",
+			"retry_wait_min must not be greater than retry_wait_max",
+		)
+		return
+	}
+
+	client := client.NewClient(client.Config{
+		URL:                     baseURL,
+		APIKey:                  apiKey,
+		OAuth:                   oauth,
+		InsecureSkipVerify:      insecureSkipVerify,
+		RootCAs:                 rootCAs,
+		ProxyURL:                proxyURL,
+		MaxConcurrentOperations: maxConcurrentOperations,
+		RateLimit:               rateLimit,
+		RateLimitBurst:          rateLimitBurst,
+		UserAgent:               fmt.Sprintf("terraform-provider-berth/%s", p.version),
+		Headers:                 headers,
+		HTTP2:                   http2,
+		TLSSessionResumption:    config.TLSSessionResumption.ValueBool(),
+		DisableKeepAlives:       config.DisableKeepAlives.ValueBool(),
+		MaxRetries:              maxRetries,
+		RetryWaitMin:            retryWaitMin,
+		RetryWaitMax:            retryWaitMax,
+	})
+
+	providerData := &ProviderData{
+		Client:             client,
+		RuleConcurrency:    ruleConcurrency,
+		ValidateReferences: config.ValidateReferences.ValueBool(),
+		AuthMethod:         authMethod,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+func loadRootCAs(pemValue, fileValue types.String) (*x509.CertPool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !pemValue.IsNull() && !fileValue.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ca_cert_pem"),
+			"Conflicting CA certificate configuration",
+			"Only one of ca_cert_pem or ca_cert_file can be set.",
+		)
+		return nil, diags
+	}
+
+	var pemData []byte
+	attributePath := path.Root("ca_cert_pem")
+	switch {
+	case !pemValue.IsNull():
+		pemData = []byte(pemValue.ValueString())
+	case !fileValue.IsNull():
+		attributePath = path.Root("ca_cert_file")
+		data, err := os.ReadFile(fileValue.ValueString())
+		if err != nil {
+			diags.AddAttributeError(attributePath, "Failed to read CA certificate file", err.Error())
+			return nil, diags
+		}
+		pemData = data
+	default:
+		return nil, diags
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pemData) {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid CA certificate",
+			"No PEM-encoded certificates could be parsed from the configured CA certificate.",
+		)
+		return nil, diags
+	}
+
+	return pool, diags
+}
+
+func readAPIKeyFile(name string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		diags.AddAttributeError(path.Root("api_key_file"), "Unable to read api_key_file", err.Error())
+		return "", diags
+	}
+
+	apiKey := strings.TrimSpace(string(data))
+	if apiKey == "" {
+		diags.AddAttributeError(path.Root("api_key_file"), "Invalid api_key_file", fmt.Sprintf("%s is empty", name))
+	}
+
+	return apiKey, diags
+}
+
+func runAPIKeyCommand(ctx context.Context, value types.List) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var args []string
+	diags.Append(value.ElementsAs(ctx, &args, false)...)
+	if diags.HasError() {
+		return "", diags
+	}
+	if len(args) == 0 || args[0] == "" {
+		diags.AddAttributeError(path.Root("api_key_command"), "Invalid api_key_command", "api_key_command must contain at least the command to run")
+		return "", diags
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		detail := fmt.Sprintf("Running %s failed: %s", args[0], err)
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			detail += "\n\n" + msg
+		}
+		diags.AddAttributeError(path.Root("api_key_command"), "Unable to run api_key_command", detail)
+		return "", diags
+	}
+
+	apiKey := strings.TrimSpace(string(output))
+	if apiKey == "" {
+		diags.AddAttributeError(path.Root("api_key_command"), "Invalid api_key_command", fmt.Sprintf("%s did not print an API key", args[0]))
+	}
+
+	return apiKey, diags
+}
+
+func parseOAuthConfig(ctx context.Context, model *OAuthModel) (*client.OAuthConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	oauth := &client.OAuthConfig{
+		TokenURL:     model.TokenURL.ValueString(),
+		ClientID:     model.ClientID.ValueString(),
+		ClientSecret: model.ClientSecret.ValueString(),
+	}
+	if !model.Scopes.IsNull() {
+		diags.Append(model.Scopes.ElementsAs(ctx, &oauth.Scopes, false)...)
+	}
+
+	tokenURL, err := url.Parse(oauth.TokenURL)
+	if err == nil && (tokenURL.Scheme != "http" && tokenURL.Scheme != "https" || tokenURL.Host == "") {
+		err = fmt.Errorf("token_url must be an absolute http or https URL, got: %q", oauth.TokenURL)
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("oauth").AtName("token_url"), "Invalid token_url", err.Error())
+	}
+
+	if oauth.ClientID == "" {
+		diags.AddAttributeError(path.Root("oauth").AtName("client_id"), "Invalid client_id", "client_id must not be empty")
+	}
+
+	return oauth, diags
+}
+
+func parseProxyURL(value types.String) (*url.URL, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return nil, diags
+	}
+
+	proxyURL, err := url.Parse(value.ValueString())
+	if err == nil && proxyURL.Host == "" {
+		err = fmt.Errorf("missing host")
+	}
+	if err == nil {
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			err = fmt.Errorf("unsupported scheme %q, expected http, https or socks5", proxyURL.Scheme)
+		}
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("proxy_url"), "Invalid proxy_url", err.Error())
+		return nil, diags
+	}
+
+	return proxyURL, diags
+}
+
+func parseDurationAttribute(value types.String, attributePath path.Path, defaultValue time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return defaultValue, diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid duration",
+			fmt.Sprintf("Expected a non-negative duration such as '1s', got: %q", value.ValueString()),
+		)
+		return 0, diags
+	}
+
+	return duration, diags
 }
 
 func (p *BerthProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRoleResource,
 		NewRolePermissionResource,
+		NewServerResource,
+		NewStackResource,
+		NewStackEnvFileResource,
+		NewStackEnvironmentResource,
+		NewUserResource,
+		NewUserRoleAssignmentResource,
 	}
 }
 
 func (p *BerthProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewContainerDataSource,
+		NewCurrentIdentityDataSource,
+		NewDockerNetworksDataSource,
+		NewEventsDataSource,
+		NewFleetHealthDataSource,
+		NewImageVulnerabilitiesDataSource,
+		NewOperationLogDataSource,
+		NewOrphanedPermissionsDataSource,
+		NewPermissionUsageDataSource,
+		NewRoleDataSource,
+		NewRolePermissionsDataSource,
+		NewRolesDataSource,
+		NewServersDataSource,
+		NewStackDriftDataSource,
+		NewStackPortsDataSource,
+		NewStackStatsDataSource,
+		NewSystemInfoDataSource,
+		NewUserDataSource,
+		NewUsersDataSource,
+	}
+}
+
+func (p *BerthProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeBerthURLFunction,
+		NewRolePermissionsDiffFunction,
+	}
 }
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func configureProvider(t *testing.T, model BerthProviderModel) provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	requireNoDiags(t, schemaResp.Diagnostics)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	requireNoDiags(t, state.Set(ctx, &model))
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, &resp)
+	return resp
+}
+
+func emptyProviderModel() BerthProviderModel {
+	return BerthProviderModel{
+		URL:                     types.StringNull(),
+		APIKey:                  types.StringNull(),
+		APIKeyFile:              types.StringNull(),
+		APIKeyCommand:           types.ListNull(types.StringType),
+		InsecureSkipVerify:      types.BoolNull(),
+		CACertPEM:               types.StringNull(),
+		CACertFile:              types.StringNull(),
+		ProxyURL:                types.StringNull(),
+		MaxConcurrentOperations: types.Int64Null(),
+		RuleConcurrency:         types.Int64Null(),
+		ValidateReferences:      types.BoolNull(),
+		HTTP2:                   types.StringNull(),
+		TLSSessionResumption:    types.BoolNull(),
+		DisableKeepAlives:       types.BoolNull(),
+		Headers:                 types.MapNull(types.StringType),
+		MaxRetries:              types.Int64Null(),
+		RetryWaitMin:            types.StringNull(),
+		RetryWaitMax:            types.StringNull(),
+	}
+}
+
+func TestProviderConfigure_EnvironmentFallback(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	t.Setenv("BERTH_URL", fake.URL)
+	t.Setenv("BERTH_API_KEY", berthtest.APIKey)
+	t.Setenv("BERTH_INSECURE_SKIP_VERIFY", "true")
+
+	resp := configureProvider(t, emptyProviderModel())
+	requireNoDiags(t, resp.Diagnostics)
+	if got := fake.RequestCount(http.MethodGet, "/api/v1/version"); got != 0 {
+		t.Fatalf("expected Configure not to probe Berth, got %d version requests", got)
+	}
+
+	data, ok := resp.ResourceData.(*ProviderData)
+	if !ok {
+		t.Fatalf("expected *ProviderData, got %T", resp.ResourceData)
+	}
+	if resp.DataSourceData != data {
+		t.Fatalf("expected data sources to share the provider data, got %T", resp.DataSourceData)
+	}
+	if data.RuleConcurrency != 4 || data.ValidateReferences || data.AuthMethod != authAPIKey {
+		t.Fatalf("unexpected provider settings: %+v", data)
+	}
+	if _, err := data.Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProviderConfigure_MissingCredentials(t *testing.T) {
+	t.Setenv("BERTH_URL", "")
+	t.Setenv("BERTH_API_KEY", "")
+
+	resp := configureProvider(t, emptyProviderModel())
+	if got := errorSummaries(resp.Diagnostics); len(got) != 2 || got[0] != "Missing Berth URL" || got[1] != "Missing Berth API key" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_InvalidInsecureSkipVerify(t *testing.T) {
+	t.Setenv("BERTH_URL", "https://berth.example.com")
+	t.Setenv("BERTH_API_KEY", "key")
+	t.Setenv("BERTH_INSECURE_SKIP_VERIFY", "sometimes")
+
+	resp := configureProvider(t, emptyProviderModel())
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid BERTH_INSECURE_SKIP_VERIFY" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_InvalidRetryWaits(t *testing.T) {
+	t.Setenv("BERTH_URL", "https://berth.example.com")
+	t.Setenv("BERTH_API_KEY", "key")
+
+	model := emptyProviderModel()
+	model.RetryWaitMin = types.StringValue("10s")
+	model.RetryWaitMax = types.StringValue("1s")
+
+	resp := configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid retry_wait_min" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+
+	model.RetryWaitMax = types.StringValue("soon")
+
+	resp = configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid duration" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_CACertificate(t *testing.T) {
+	fake := berthtest.NewTLSServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: fake.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, set := range map[string]func(*BerthProviderModel){
+		"pem":  func(m *BerthProviderModel) { m.CACertPEM = types.StringValue(string(caPEM)) },
+		"file": func(m *BerthProviderModel) { m.CACertFile = types.StringValue(caFile) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			model := emptyProviderModel()
+			model.URL = types.StringValue(fake.URL)
+			model.APIKey = types.StringValue(berthtest.APIKey)
+			set(&model)
+
+			resp := configureProvider(t, model)
+			requireNoDiags(t, resp.Diagnostics)
+
+			if _, err := resp.ResourceData.(*ProviderData).Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure_InvalidCACertificate(t *testing.T) {
+	model := emptyProviderModel()
+	model.URL = types.StringValue("https://berth.example.com")
+	model.APIKey = types.StringValue("key")
+	model.CACertPEM = types.StringValue("not a certificate")
+
+	resp := configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid CA certificate" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+
+	model.CACertFile = types.StringValue("/nonexistent/ca.pem")
+
+	resp = configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Conflicting CA certificate configuration" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_ProxyURL(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	model := emptyProviderModel()
+	model.URL = types.StringValue("http://berth.invalid")
+	model.APIKey = types.StringValue(berthtest.APIKey)
+	model.ProxyURL = types.StringValue(fake.URL)
+
+	resp := configureProvider(t, model)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, err := resp.ResourceData.(*ProviderData).Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+		t.Fatalf("expected request to be sent through the proxy: %v", err)
+	}
+
+	model.ProxyURL = types.StringValue("ftp://proxy.example.com")
+
+	resp = configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid proxy_url" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_Headers(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	model := emptyProviderModel()
+	model.URL = types.StringValue(fake.URL)
+	model.APIKey = types.StringValue(berthtest.APIKey)
+	model.Headers = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"X-Tenant": types.StringValue("platform"),
+	})
+
+	resp := configureProvider(t, model)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, err := resp.ResourceData.(*ProviderData).Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+		t.Fatal(err)
+	}
+
+	headers := fake.LastRequestHeaders()
+	if got := headers.Get("User-Agent"); got != "terraform-provider-berth/test" {
+		t.Fatalf("unexpected User-Agent %q", got)
+	}
+	if got := headers.Get("X-Tenant"); got != "platform" {
+		t.Fatalf("unexpected X-Tenant %q", got)
+	}
+
+	model.Headers = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"authorization": types.StringValue("Bearer other"),
+	})
+
+	resp = configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Invalid headers" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_APIKeySources(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	t.Setenv("BERTH_API_KEY", "")
+
+	keyFile := filepath.Join(t.TempDir(), "api_key")
+	if err := os.WriteFile(keyFile, []byte(berthtest.APIKey+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fileModel := emptyProviderModel()
+	fileModel.URL = types.StringValue(fake.URL)
+	fileModel.APIKeyFile = types.StringValue(keyFile)
+
+	commandModel := emptyProviderModel()
+	commandModel.URL = types.StringValue(fake.URL)
+	commandModel.APIKeyCommand = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("echo"),
+		types.StringValue(berthtest.APIKey),
+	})
+
+	for name, model := range map[string]BerthProviderModel{"file": fileModel, "command": commandModel} {
+		t.Run(name, func(t *testing.T) {
+			resp := configureProvider(t, model)
+			requireNoDiags(t, resp.Diagnostics)
+
+			if _, err := resp.ResourceData.(*ProviderData).Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	fileModel.APIKey = types.StringValue(berthtest.APIKey)
+	fileModel.APIKeyFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+
+	resp := configureProvider(t, fileModel)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 2 || got[0] != "Conflicting authentication" || got[1] != "Unable to read api_key_file" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+
+	commandModel.APIKeyCommand = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("false")})
+
+	resp = configureProvider(t, commandModel)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Unable to run api_key_command" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func TestProviderConfigure_OAuth(t *testing.T) {
+	fake := berthtest.NewServer()
+	t.Cleanup(fake.Close)
+	fake.AddRole("deployers", "")
+
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if clientID, clientSecret, _ := r.BasicAuth(); clientID != "terraform" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"access_token":"` + berthtest.APIKey + `","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	t.Setenv("BERTH_API_KEY", "")
+
+	model := emptyProviderModel()
+	model.URL = types.StringValue(fake.URL)
+	model.OAuth = &OAuthModel{
+		TokenURL:     types.StringValue(tokenServer.URL),
+		ClientID:     types.StringValue("terraform"),
+		ClientSecret: types.StringValue("secret"),
+		Scopes:       types.ListNull(types.StringType),
+	}
+
+	resp := configureProvider(t, model)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if _, err := resp.ResourceData.(*ProviderData).Client.GetRoleByName(context.Background(), "deployers"); err != nil {
+		t.Fatal(err)
+	}
+
+	model.APIKey = types.StringValue(berthtest.APIKey)
+	model.OAuth.TokenURL = types.StringValue("token")
+
+	resp = configureProvider(t, model)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 2 || got[0] != "Conflicting authentication" || got[1] != "Invalid token_url" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func errorSummaries(diags diag.Diagnostics) []string {
+	var summaries []string
+	for _, d := range diags.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	return summaries
+}
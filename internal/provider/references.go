@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+type plannedServerID struct {
+	Path path.Path
+	ID   types.Int64
+}
+
+func plannedRoleServerIDs(ctx context.Context, plan tfsdk.Plan) ([]plannedServerID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var ids []plannedServerID
+
+	var permissions types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	for _, elem := range permissions.Elements() {
+		object, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		if id, ok := object.Attributes()["server_id"].(types.Int64); ok {
+			ids = append(ids, plannedServerID{
+				Path: path.Root("permissions").AtSetValue(elem).AtName("server_id"),
+				ID:   id,
+			})
+		}
+	}
+
+	var permissionSets types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("permission_set"), &permissionSets)...)
+	for _, elem := range permissionSets.Elements() {
+		set, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		serverIDs, ok := set.Attributes()["server_ids"].(types.Set)
+		if !ok {
+			continue
+		}
+		for _, serverID := range serverIDs.Elements() {
+			if id, ok := serverID.(types.Int64); ok {
+				ids = append(ids, plannedServerID{
+					Path: path.Root("permission_set").AtSetValue(elem).AtName("server_ids").AtSetValue(serverID),
+					ID:   id,
+				})
+			}
+		}
+	}
+
+	return ids, diags
+}
+
+func checkServersExist(ctx context.Context, data *ProviderData, ids []plannedServerID) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil || !data.ValidateReferences {
+		return diags
+	}
+
+	known := make([]plannedServerID, 0, len(ids))
+	for _, id := range ids {
+		if !id.ID.IsNull() && !id.ID.IsUnknown() {
+			known = append(known, id)
+		}
+	}
+	if len(known) == 0 {
+		return diags
+	}
+
+	servers, err := data.Client.ListServers(ctx)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to validate server references",
+			fmt.Sprintf("Listing Berth servers failed, so server IDs are not checked until apply: %s", err),
+		)
+		return diags
+	}
+
+	existing := make(map[int64]bool, len(servers))
+	available := make([]string, 0, len(servers))
+	for _, server := range servers {
+		existing[int64(server.ID)] = true
+		available = append(available, fmt.Sprintf("%s (%d)", server.Name, server.ID))
+	}
+
+	for _, id := range known {
+		if existing[id.ID.ValueInt64()] {
+			continue
+		}
+		diags.AddAttributeError(
+			id.Path,
+			"Server not found",
+			fmt.Sprintf("Berth has no server with ID %d. Available servers: %s.", id.ID.ValueInt64(), strings.Join(available, ", ")),
+		)
+	}
+
+	return diags
+}
+
+func validateServerReference(serverIDPath path.Path, serverID types.Int64, serverName types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case !serverID.IsNull() && !serverName.IsNull():
+		diags.AddAttributeError(
+			serverIDPath,
+			"Conflicting server reference",
+			"Set either server_id or server_name, not both.",
+		)
+	case serverID.IsNull() && serverName.IsNull():
+		diags.AddAttributeError(
+			serverIDPath,
+			"Missing server reference",
+			"One of server_id or server_name must be set.",
+		)
+	}
+
+	return diags
+}
+
+func serverIDsByName(ctx context.Context, c client.BerthAPI) (map[string]int64, error) {
+	servers, err := c.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]int64, len(servers))
+	for _, server := range servers {
+		ids[server.Name] = int64(server.ID)
+	}
+	return ids, nil
+}
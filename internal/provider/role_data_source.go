@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &RoleDataSource{}
+
+func NewRoleDataSource() datasource.DataSource {
+	return &RoleDataSource{}
+}
+
+type RoleDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type RoleDataSourceModel struct {
+	ID                types.String           `tfsdk:"id"`
+	Name              types.String           `tfsdk:"name"`
+	ExpandPermissions types.Bool             `tfsdk:"expand_permissions"`
+	Description       types.String           `tfsdk:"description"`
+	IsAdmin           types.Bool             `tfsdk:"is_admin"`
+	Permissions       []RolePermissionInline `tfsdk:"permissions"`
+}
+
+func (d *RoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (d *RoleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Berth role by name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Role ID",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Role name",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Role description",
+				Computed:    true,
+			},
+			"is_admin": schema.BoolAttribute{
+				Description: "Whether the role is an admin role",
+				Computed:    true,
+			},
+			"expand_permissions": schema.BoolAttribute{
+				Description: "Whether to read the role's permission rules into permissions. Defaults to true; set to false to skip the extra API calls",
+				Optional:    true,
+			},
+			"permissions": rolePermissionsAttribute("Permission rules currently assigned to the role. Null when expand_permissions is false"),
+		},
+	}
+}
+
+func rolePermissionsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "Permission rule ID",
+					Computed:    true,
+				},
+				"server_id": schema.Int64Attribute{
+					Description: "Server ID",
+					Computed:    true,
+				},
+				"server_name": schema.StringAttribute{
+					Description: "Server name",
+					Computed:    true,
+				},
+				"permission_name": schema.StringAttribute{
+					Description: "Permission name",
+					Computed:    true,
+				},
+				"stack_pattern": schema.StringAttribute{
+					Description: "Stack pattern",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func (d *RoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *RoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RoleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := d.client.GetRoleByName(ctx, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find role", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", data.Name.ValueString()), err))
+		return
+	}
+
+	if data.ExpandPermissions.IsNull() || data.ExpandPermissions.ValueBool() {
+		serverNames, err := serverNamesByID(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+			return
+		}
+
+		data.Permissions, err = readRolePermissions(ctx, d.client, role.ID, serverNames)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", role.Name), err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(strconv.FormatUint(uint64(role.ID), 10))
+	data.Description = types.StringValue(role.Description)
+	data.IsAdmin = types.BoolValue(role.IsAdmin)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func serverNamesByID(ctx context.Context, c client.BerthAPI) (map[uint]string, error) {
+	servers, err := c.ListServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uint]string, len(servers))
+	for _, server := range servers {
+		names[server.ID] = server.Name
+	}
+	return names, nil
+}
+
+func readRolePermissions(ctx context.Context, c client.BerthAPI, roleID uint, serverNames map[uint]string) ([]RolePermissionInline, error) {
+	perms, allPermissions, err := c.ListRolePermissions(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+
+	permMap := make(map[uint]string, len(allPermissions))
+	for _, p := range allPermissions {
+		permMap[p.ID] = p.Name
+	}
+
+	permissions := make([]RolePermissionInline, 0, len(perms))
+	for _, perm := range perms {
+		permissions = append(permissions, RolePermissionInline{
+			ID:             types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+			ServerID:       types.Int64Value(int64(perm.ServerID)),
+			ServerName:     types.StringValue(serverNames[perm.ServerID]),
+			PermissionName: types.StringValue(permMap[perm.PermissionID]),
+			StackPattern:   types.StringValue(perm.StackPattern),
+		})
+	}
+	return permissions, nil
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRoleDataSource(t *testing.T) {
+	fake, c := newTestClient(t)
+	fake.AddRole("viewers", "")
+	role := fake.AddRole("deployers", "Deploys stacks")
+	fake.AddRule(role.ID, 1, "stacks.manage", "app-*")
+
+	state, diags := readDataSource(t, NewRoleDataSource(), c, RoleDataSourceModel{
+		ID:                types.StringNull(),
+		Name:              types.StringValue("deployers"),
+		ExpandPermissions: types.BoolNull(),
+		Description:       types.StringNull(),
+		IsAdmin:           types.BoolNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data RoleDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.ID.ValueString() != strconv.Itoa(int(role.ID)) || data.Description.ValueString() != "Deploys stacks" || data.IsAdmin.ValueBool() {
+		t.Fatalf("unexpected role: %+v", data)
+	}
+	if len(data.Permissions) != 1 || data.Permissions[0].PermissionName.ValueString() != "stacks.manage" || data.Permissions[0].StackPattern.ValueString() != "app-*" {
+		t.Fatalf("unexpected permissions: %+v", data.Permissions)
+	}
+}
+
+func TestRoleDataSource_NotFound(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, diags := readDataSource(t, NewRoleDataSource(), c, RoleDataSourceModel{
+		ID:                types.StringNull(),
+		Name:              types.StringValue("missing"),
+		ExpandPermissions: types.BoolNull(),
+		Description:       types.StringNull(),
+		IsAdmin:           types.BoolNull(),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a missing role")
+	}
+}
+
+func TestRoleDataSource_ExpandPermissionsDisabled(t *testing.T) {
+	fake, c := newTestClient(t)
+	role := fake.AddRole("deployers", "")
+	fake.AddRule(role.ID, 1, "stacks.manage", "app-*")
+
+	state, diags := readDataSource(t, NewRoleDataSource(), c, RoleDataSourceModel{
+		ID:                types.StringNull(),
+		Name:              types.StringValue("deployers"),
+		ExpandPermissions: types.BoolValue(false),
+		Description:       types.StringNull(),
+		IsAdmin:           types.BoolNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data RoleDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.Permissions != nil {
+		t.Fatalf("expected permissions to be null, got %+v", data.Permissions)
+	}
+	if got := fake.RequestCount(http.MethodGet, fmt.Sprintf("/api/v1/admin/roles/%d/stack-permissions", role.ID)); got != 0 {
+		t.Fatalf("expected no permission reads, got %d", got)
+	}
+}
@@ -6,30 +6,41 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
 )
 
+const defaultStackPattern = "*"
+
 var _ resource.Resource = &RolePermissionResource{}
 var _ resource.ResourceWithImportState = &RolePermissionResource{}
+var _ resource.ResourceWithMoveState = &RolePermissionResource{}
+var _ resource.ResourceWithModifyPlan = &RolePermissionResource{}
+var _ resource.ResourceWithValidateConfig = &RolePermissionResource{}
 
 func NewRolePermissionResource() resource.Resource {
 	return &RolePermissionResource{}
 }
 
 type RolePermissionResource struct {
-	client *client.Client
+	client       client.BerthAPI
+	providerData *ProviderData
 }
 
 type RolePermissionResourceModel struct {
 	ID             types.String `tfsdk:"id"`
 	RoleID         types.Int64  `tfsdk:"role_id"`
 	ServerID       types.Int64  `tfsdk:"server_id"`
+	ServerName     types.String `tfsdk:"server_name"`
 	PermissionName types.String `tfsdk:"permission_name"`
 	StackPattern   types.String `tfsdk:"stack_pattern"`
 }
@@ -40,7 +51,7 @@ func (r *RolePermissionResource) Metadata(ctx context.Context, req resource.Meta
 
 func (r *RolePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Berth role permission for server/stack access",
+		Description: "Manages a Berth role permission for server/stack access. A moved block from berth_role transfers only the first of the role's rules, ordered by server, permission and stack pattern; import the remaining rules with their role_id:permission_id IDs",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Permission ID",
@@ -52,18 +63,41 @@ func (r *RolePermissionResource) Schema(ctx context.Context, req resource.Schema
 			"role_id": schema.Int64Attribute{
 				Description: "Role ID",
 				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
 			},
 			"server_id": schema.Int64Attribute{
-				Description: "Server ID",
-				Required:    true,
+				Description: "Server ID. Exactly one of server_id or server_name must be set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"server_name": schema.StringAttribute{
+				Description: "Server name, resolved to server_id through the Berth API. Use instead of server_id when IDs differ between Berth instances",
+				Optional:    true,
 			},
 			"permission_name": schema.StringAttribute{
 				Description: "Permission name (e.g., 'stacks.read', 'stacks.manage', 'stacks.create', 'files.read', 'files.write', 'logs.read')",
 				Required:    true,
+				Validators: []validator.String{
+					permissionNameValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"stack_pattern": schema.StringAttribute{
-				Description: "Stack name pattern (supports wildcards, e.g., '*', 'prod-*')",
+				Description: "Stack name pattern (supports wildcards, e.g., '*', 'prod-*'). Defaults to '*'",
 				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultStackPattern),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 		},
 	}
@@ -74,16 +108,83 @@ func (r *RolePermissionResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *RolePermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var serverID types.Int64
+	var serverName types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("server_id"), &serverID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("server_name"), &serverName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateServerReference(path.Root("server_id"), serverID, serverName)...)
+}
+
+func (r *RolePermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name, serverName types.String
+	var serverID types.Int64
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("permission_name"), &name)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("server_name"), &serverName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !serverName.IsNull() {
+		var priorServerID types.Int64
+		var priorServerName types.String
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("server_id"), &priorServerID)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("server_name"), &priorServerName)...)
+		}
+
+		switch {
+		case r.client != nil && !serverName.IsUnknown():
+			resolved, err := r.client.GetServerByName(ctx, serverName.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("server_name"), "Failed to find server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %q", serverName.ValueString()), err))
+				return
+			}
+			serverID = types.Int64Value(int64(resolved.ID))
+		case serverName.Equal(priorServerName):
+			serverID = priorServerID
+		default:
+			serverID = types.Int64Unknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+
+		if !req.State.Raw.IsNull() && !serverID.Equal(priorServerID) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("server_id"))
+		}
+	} else {
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("server_id"), &serverID)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPermissionsSupported(ctx, r.providerData, []plannedPermissionName{
+		{Path: path.Root("permission_name"), Name: name},
+	})...)
+	resp.Diagnostics.Append(checkServersExist(ctx, r.providerData, []plannedServerID{
+		{Path: path.Root("server_id"), ID: serverID},
+	})...)
 }
 
 func (r *RolePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -94,48 +195,35 @@ func (r *RolePermissionResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	permission, err := r.client.GetPermissionByName(data.PermissionName.ValueString())
+	if data.ServerID.IsUnknown() {
+		server, err := r.client.GetServerByName(ctx, data.ServerName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("server_name"), "Failed to find server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %q", data.ServerName.ValueString()), err))
+			return
+		}
+		data.ServerID = types.Int64Value(int64(server.ID))
+	}
+
+	permission, err := r.client.GetPermissionByName(ctx, data.PermissionName.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to find permission", err.Error())
+		resp.Diagnostics.AddError("Failed to find permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission %q", data.PermissionName.ValueString()), err))
 		return
 	}
 
-	stackPattern := "*"
-	if !data.StackPattern.IsNull() {
-		stackPattern = data.StackPattern.ValueString()
-	}
+	stackPattern := stackPatternValue(data.StackPattern)
 
-	perm, err := r.client.CreateRolePermission(
+	perm, err := r.client.CreateRolePermission(ctx,
 		uint(data.RoleID.ValueInt64()),
 		uint(data.ServerID.ValueInt64()),
 		permission.ID,
 		stackPattern,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create role permission", err.Error())
+		resp.Diagnostics.AddError("Failed to create role permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission %q on server %d for role %d", data.PermissionName.ValueString(), data.ServerID.ValueInt64(), data.RoleID.ValueInt64()), err))
 		return
 	}
 
-	perms, _, err := r.client.ListRolePermissions(uint(data.RoleID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to read created permission", err.Error())
-		return
-	}
-
-	var foundID uint
-	for _, p := range perms {
-		if p.ServerID == perm.ServerID && p.PermissionID == permission.ID && p.StackPattern == stackPattern {
-			foundID = p.ID
-			break
-		}
-	}
-
-	if foundID == 0 {
-		resp.Diagnostics.AddError("Failed to find created permission", "Permission was created but could not be found")
-		return
-	}
-
-	data.ID = types.StringValue(strconv.FormatUint(uint64(foundID), 10))
+	data.ID = types.StringValue(strconv.FormatUint(uint64(perm.ID), 10))
 	data.StackPattern = types.StringValue(stackPattern)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -155,23 +243,31 @@ func (r *RolePermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	perm, err := r.client.GetRolePermission(uint(data.RoleID.ValueInt64()), uint(id))
+	perm, err := r.client.GetRolePermission(ctx, uint(data.RoleID.ValueInt64()), uint(id))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read role permission", err.Error())
+		resp.Diagnostics.AddError("Failed to read role permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission rule %d of role %d", id, data.RoleID.ValueInt64()), err))
 		return
 	}
 
 	data.ServerID = types.Int64Value(int64(perm.ServerID))
-	data.StackPattern = types.StringValue(perm.StackPattern)
+	data.StackPattern = types.StringValue(normalizeStackPattern(perm.StackPattern))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RolePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Update not supported",
-		"Role permissions cannot be updated. Please delete and recreate the resource.",
-	)
+	var data RolePermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RolePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -188,28 +284,212 @@ func (r *RolePermissionResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	if err := r.client.DeleteRolePermission(uint(data.RoleID.ValueInt64()), uint(id)); err != nil {
-		resp.Diagnostics.AddError("Failed to delete role permission", err.Error())
+	if err := r.client.DeleteRolePermission(ctx, uint(data.RoleID.ValueInt64()), uint(id)); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete role permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission rule %d of role %d", id, data.RoleID.ValueInt64()), err))
 		return
 	}
 }
 
 func (r *RolePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, ":")
-	if len(parts) != 2 {
+	switch n := len(parts); {
+	case n == 2:
+		roleID, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid role ID", err.Error())
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	case n >= 4:
+		r.importByNaturalKey(ctx, strings.Join(parts[:n-3], ":"), parts[n-3], parts[n-2], normalizeStackPattern(parts[n-1]), resp)
+	default:
 		resp.Diagnostics.AddError(
 			"Invalid import ID",
-			"Import ID must be in format 'role_id:permission_id'",
+			"Import ID must be in format 'role_id:permission_id' or 'role_name:server_id:permission_name:stack_pattern'. Role names may contain ':' since the last three fields are read from the end",
 		)
+	}
+}
+
+func (r *RolePermissionResource) importByNaturalKey(ctx context.Context, roleName, serverIDValue, permissionName, stackPattern string, resp *resource.ImportStateResponse) {
+	serverID, err := strconv.ParseInt(serverIDValue, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
 		return
 	}
 
-	roleID, err := strconv.ParseInt(parts[0], 10, 64)
+	role, err := r.client.GetRoleByName(ctx, roleName)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid role ID", err.Error())
+		resp.Diagnostics.AddError("Failed to find role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %q", roleName), err))
+		return
+	}
+
+	permission, err := r.client.GetPermissionByName(ctx, permissionName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission %q", permissionName), err))
+		return
+	}
+
+	perms, _, err := r.client.ListRolePermissions(ctx, role.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list role permissions", apiErrorDetail(r.providerData, fmt.Sprintf("Role %q", roleName), err))
+		return
+	}
+
+	for _, perm := range perms {
+		if int64(perm.ServerID) != serverID || perm.PermissionID != permission.ID || normalizeStackPattern(perm.StackPattern) != stackPattern {
+			continue
+		}
+
+		data := RolePermissionResourceModel{
+			ID:             types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+			RoleID:         types.Int64Value(int64(role.ID)),
+			ServerID:       types.Int64Value(serverID),
+			ServerName:     types.StringNull(),
+			PermissionName: types.StringValue(permissionName),
+			StackPattern:   types.StringValue(stackPattern),
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+	resp.Diagnostics.AddError(
+		"Role permission not found",
+		fmt.Sprintf("Role %q has no %q rule on server %d with stack pattern %q.", roleName, permissionName, serverID, stackPattern),
+	)
+}
+
+func (r *RolePermissionResource) MoveState(ctx context.Context) []resource.StateMover {
+	var sourceSchema resource.SchemaResponse
+	(&RoleResource{}).Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "berth_role" || !isBerthProviderAddress(req.SourceProviderAddress) || req.SourceState == nil {
+					return
+				}
+
+				var source RoleResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				roleID, err := strconv.ParseInt(source.ID.ValueString(), 10, 64)
+				if err != nil {
+					resp.Diagnostics.AddError("Invalid role ID", err.Error())
+					return
+				}
+
+				keys := source.declaredRuleKeys()
+				sortRoleRuleKeys(keys)
+				if len(keys) == 0 {
+					resp.Diagnostics.AddError(
+						"Unable to move berth_role",
+						"The berth_role has no permissions or permission_set rules in state, so there is no rule to move to berth_role_permission.",
+					)
+					return
+				}
+
+				ruleIDs, diags := r.roleRuleIDs(ctx, uint(roleID), source.Permissions)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				key := keys[0]
+				if ruleIDs[key] == "" {
+					resp.Diagnostics.AddError(
+						"Unable to move berth_role",
+						fmt.Sprintf("Role %d has no %q rule on server %d with stack pattern %q in Berth.", roleID, key.permissionName, key.serverID, key.stackPattern),
+					)
+					return
+				}
+
+				data := RolePermissionResourceModel{
+					ID:             types.StringValue(ruleIDs[key]),
+					RoleID:         types.Int64Value(roleID),
+					ServerID:       types.Int64Value(key.serverID),
+					ServerName:     types.StringNull(),
+					PermissionName: types.StringValue(key.permissionName),
+					StackPattern:   types.StringValue(key.stackPattern),
+				}
+				for _, perm := range source.Permissions {
+					if newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern) == key {
+						data.ServerName = perm.ServerName
+					}
+				}
+
+				if len(keys) > 1 {
+					imports := make([]string, 0, len(keys)-1)
+					for _, other := range keys[1:] {
+						if id := ruleIDs[other]; id != "" {
+							imports = append(imports, fmt.Sprintf("%q (%s on server %d, pattern %q)", fmt.Sprintf("%d:%s", roleID, id), other.permissionName, other.serverID, other.stackPattern))
+						}
+					}
+					resp.Diagnostics.AddWarning(
+						"Only one permission rule moved",
+						fmt.Sprintf("A moved block transfers a single rule. The %s rule on server %d with stack pattern %q was moved; "+
+							"the berth_role_permission receiving it must be configured for that rule, otherwise Terraform replaces it. "+
+							"Bring the remaining rules under management with import blocks using these IDs: %s.",
+							key.permissionName, key.serverID, key.stackPattern, strings.Join(imports, ", ")),
+					)
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+func (r *RolePermissionResource) roleRuleIDs(ctx context.Context, roleID uint, inline []RolePermissionInline) (map[roleRuleKey]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ids := make(map[roleRuleKey]string)
+	for _, perm := range inline {
+		if !perm.ID.IsNull() && !perm.ID.IsUnknown() {
+			ids[newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern)] = perm.ID.ValueString()
+		}
+	}
+	if r.client == nil {
+		return ids, diags
+	}
+
+	perms, allPermissions, err := r.client.ListRolePermissions(ctx, roleID)
+	if err != nil {
+		diags.AddError("Failed to list role permissions", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", roleID), err))
+		return nil, diags
+	}
+
+	names := make(map[uint]string, len(allPermissions))
+	for _, p := range allPermissions {
+		names[p.ID] = p.Name
+	}
+	for _, perm := range perms {
+		key := roleRuleKey{int64(perm.ServerID), names[perm.PermissionID], normalizeStackPattern(perm.StackPattern)}
+		ids[key] = strconv.FormatUint(uint64(perm.ID), 10)
+	}
+
+	return ids, diags
+}
+
+func stackPatternValue(value types.String) string {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultStackPattern
+	}
+	return normalizeStackPattern(value.ValueString())
+}
+
+func normalizeStackPattern(pattern string) string {
+	if pattern == "" {
+		return defaultStackPattern
+	}
+	return pattern
+}
+
+func isBerthProviderAddress(address string) bool {
+	return strings.HasSuffix(address, "tech-arch1tect/berth")
 }
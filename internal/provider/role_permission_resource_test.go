@@ -0,0 +1,450 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+func TestRolePermissionResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.create(RolePermissionResourceModel{
+		ID:             types.StringUnknown(),
+		RoleID:         types.Int64Value(int64(role.ID)),
+		ServerID:       types.Int64Value(int64(server.ID)),
+		ServerName:     types.StringNull(),
+		PermissionName: types.StringValue("files.write"),
+		StackPattern:   types.StringUnknown(),
+	})
+
+	var created RolePermissionResourceModel
+	h.get(state, &created)
+
+	rules := fake.Rules(role.ID)
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %+v", rules)
+	}
+	if created.ID.ValueString() != fmt.Sprint(rules[0].ID) {
+		t.Fatalf("expected id %d, got %s", rules[0].ID, created.ID.ValueString())
+	}
+	if created.StackPattern.ValueString() != "*" {
+		t.Fatalf("expected default stack pattern, got %s", created.StackPattern)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	h.delete(state)
+	if len(fake.Rules(role.ID)) != 0 {
+		t.Fatalf("expected rule to be deleted, got %+v", fake.Rules(role.ID))
+	}
+}
+
+func TestRolePermissionResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.manage", "prod-*")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:%d", role.ID, rule.ID))
+
+	var imported RolePermissionResourceModel
+	h.get(state, &imported)
+	if imported.ServerID.ValueInt64() != int64(server.ID) || imported.StackPattern.ValueString() != "prod-*" {
+		t.Fatalf("unexpected imported permission: %+v", imported)
+	}
+}
+
+func TestRolePermissionResource_ImportByNaturalKey(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	fake.AddRule(role.ID, server.ID, "stacks.manage", "*")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.manage", "prod-*")
+	fake.AddRule(role.ID, server.ID, "stacks.read", "prod-*")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.importState(fmt.Sprintf("deployers:%d:stacks.manage:prod-*", server.ID))
+
+	var imported RolePermissionResourceModel
+	h.get(state, &imported)
+	if imported.ID.ValueString() != strconv.Itoa(int(rule.ID)) || imported.RoleID.ValueInt64() != int64(role.ID) {
+		t.Fatalf("expected rule %d of role %d, got %+v", rule.ID, role.ID, imported)
+	}
+	if imported.PermissionName.ValueString() != "stacks.manage" || imported.StackPattern.ValueString() != "prod-*" {
+		t.Fatalf("unexpected imported permission: %+v", imported)
+	}
+
+	resp := resource.ImportStateResponse{State: h.emptyState()}
+	h.resource.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{
+		ID: fmt.Sprintf("deployers:%d:logs.read:*", server.ID),
+	}, &resp)
+	if summaries := errorSummaries(resp.Diagnostics); len(summaries) != 1 || summaries[0] != "Role permission not found" {
+		t.Fatalf("expected missing rule error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestRolePermissionResource_ImportByNaturalKeyWithColonInRoleName(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("team:ops:deployers", "")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.manage", "prod-*")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.importState(fmt.Sprintf("team:ops:deployers:%d:stacks.manage:prod-*", server.ID))
+
+	var imported RolePermissionResourceModel
+	h.get(state, &imported)
+	if imported.ID.ValueString() != strconv.Itoa(int(rule.ID)) || imported.RoleID.ValueInt64() != int64(role.ID) {
+		t.Fatalf("expected rule %d of role %d, got %+v", rule.ID, role.ID, imported)
+	}
+
+	resp := resource.ImportStateResponse{State: h.emptyState()}
+	h.resource.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{
+		ID: fmt.Sprintf("%d:stacks.manage:prod-*", server.ID),
+	}, &resp)
+	if summaries := errorSummaries(resp.Diagnostics); len(summaries) != 1 || summaries[0] != "Invalid import ID" {
+		t.Fatalf("expected invalid import ID error for three fields, got %v", resp.Diagnostics)
+	}
+}
+
+func TestRolePermissionResource_EmptyStackPatternReadsAsDefault(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.read", "")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:%d", role.ID, rule.ID))
+
+	var imported RolePermissionResourceModel
+	h.get(state, &imported)
+	if imported.StackPattern.ValueString() != "*" {
+		t.Fatalf("expected empty stack pattern to read as '*', got %s", imported.StackPattern)
+	}
+}
+
+func TestRolePermissionResource_DeletedOutOfBand(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.read", "*")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:%d", role.ID, rule.ID))
+
+	fake.RemoveRule(role.ID, rule.ID)
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected deleted rule to be removed from state")
+	}
+}
+
+func TestRolePermissionResource_PermissionNameTypo(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	diags := h.modifyPlan(RolePermissionResourceModel{
+		ID:             types.StringUnknown(),
+		RoleID:         types.Int64Value(int64(role.ID)),
+		ServerID:       types.Int64Value(int64(server.ID)),
+		ServerName:     types.StringNull(),
+		PermissionName: types.StringValue("stack.read"),
+		StackPattern:   types.StringUnknown(),
+	})
+	if got := errorSummaries(diags); len(got) != 1 || !strings.Contains(diags.Errors()[0].Detail(), "did you mean 'stacks.read'?") {
+		t.Fatalf("expected a suggestion for the misspelled permission, got %v", diags)
+	}
+}
+
+func TestPermissionNameValidator(t *testing.T) {
+	for name, tc := range map[string]struct {
+		value    types.String
+		errors   int
+		warnings int
+	}{
+		"known":   {value: types.StringValue("stacks.read")},
+		"unknown": {value: types.StringUnknown()},
+		"typo":    {value: types.StringValue("stack.read"), warnings: 1},
+		"empty":   {value: types.StringValue(""), errors: 1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var resp validator.StringResponse
+			permissionNameValidator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("permission_name"),
+				ConfigValue: tc.value,
+			}, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != tc.errors || resp.Diagnostics.WarningsCount() != tc.warnings {
+				t.Fatalf("expected %d errors and %d warnings, got %v", tc.errors, tc.warnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRolePermissionResource_ServerName(t *testing.T) {
+	fake, c := newTestClient(t)
+	fake.AddServer("staging", "10.0.0.2", 8081)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "")
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	model := RolePermissionResourceModel{
+		ID:             types.StringUnknown(),
+		RoleID:         types.Int64Value(int64(role.ID)),
+		ServerID:       types.Int64Null(),
+		ServerName:     types.StringValue("prod"),
+		PermissionName: types.StringValue("stacks.read"),
+		StackPattern:   types.StringUnknown(),
+	}
+	requireNoDiags(t, h.validateConfig(model))
+
+	model.ServerID = types.Int64Unknown()
+	plan, diags := h.modifiedPlanFrom(h.emptyState(), model)
+	requireNoDiags(t, diags)
+	var planned RolePermissionResourceModel
+	requireNoDiags(t, plan.Get(context.Background(), &planned))
+	if planned.ServerID.ValueInt64() != int64(server.ID) {
+		t.Fatalf("expected server_name to resolve to %d during plan, got %s", server.ID, planned.ServerID)
+	}
+
+	state := h.create(model)
+	var created RolePermissionResourceModel
+	h.get(state, &created)
+	if created.ServerID.ValueInt64() != int64(server.ID) || created.ServerName.ValueString() != "prod" {
+		t.Fatalf("expected server %d stored alongside its name, got %+v", server.ID, created)
+	}
+	if rules := fake.Rules(role.ID); len(rules) != 1 || rules[0].ServerID != server.ID {
+		t.Fatalf("expected rule on server %d, got %+v", server.ID, rules)
+	}
+
+	model.ServerName = types.StringValue("missing")
+	if got := errorSummaries(h.modifyPlan(model)); len(got) != 1 || got[0] != "Failed to find server" {
+		t.Fatalf("unexpected diagnostics for unknown server name: %v", got)
+	}
+
+	model.ServerID = types.Int64Value(int64(server.ID))
+	model.ServerName = types.StringValue("prod")
+	if got := errorSummaries(h.validateConfig(model)); len(got) != 1 || got[0] != "Conflicting server reference" {
+		t.Fatalf("unexpected diagnostics for conflicting server reference: %v", got)
+	}
+}
+
+func TestRolePermissionResource_MockedAPI(t *testing.T) {
+	state := RolePermissionResourceModel{
+		ID:             types.StringValue("11"),
+		RoleID:         types.Int64Value(7),
+		ServerID:       types.Int64Value(1),
+		ServerName:     types.StringNull(),
+		PermissionName: types.StringValue("stacks.read"),
+		StackPattern:   types.StringValue("*"),
+	}
+	plan := state
+	plan.ID = types.StringUnknown()
+
+	tests := []struct {
+		name      string
+		api       mockBerthAPI
+		run       func(h *resourceHarness) (tfsdk.State, diag.Diagnostics)
+		wantError string
+		wantState bool
+	}{
+		{
+			name: "create",
+			api: mockBerthAPI{createRolePermission: func(roleID, serverID, permissionID uint, stackPattern string) (*client.RolePermission, error) {
+				return &client.RolePermission{ID: 11, ServerID: serverID, PermissionID: permissionID, StackPattern: stackPattern}, nil
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(plan)
+			},
+			wantState: true,
+		},
+		{
+			name: "create fails",
+			api: mockBerthAPI{createRolePermission: func(uint, uint, uint, string) (*client.RolePermission, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(plan)
+			},
+			wantError: "Failed to create role permission",
+		},
+		{
+			name: "read deleted rule",
+			api: mockBerthAPI{getRolePermission: func(uint, uint) (*client.RolePermission, error) {
+				return nil, fmt.Errorf("role permission %w", client.ErrNotFound)
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(state))
+			},
+		},
+		{
+			name: "read fails",
+			api: mockBerthAPI{getRolePermission: func(uint, uint) (*client.RolePermission, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(state))
+			},
+			wantError: "Failed to read role permission",
+			wantState: true,
+		},
+		{
+			name: "delete fails",
+			api: mockBerthAPI{deleteRolePermission: func(uint, uint) error {
+				return mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.emptyState(), h.tryDelete(h.stateFrom(state))
+			},
+			wantError: "Failed to delete role permission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newResourceHarness(t, NewRolePermissionResource(), &tt.api)
+			state, diags := tt.run(h)
+			requireDiagnostics(t, diags, tt.wantError, "")
+			if state.Raw.IsNull() == tt.wantState {
+				t.Fatalf("expected state present = %v, got %v", tt.wantState, !state.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestRolePermissionResource_MoveFromRoleWithManyRules(t *testing.T) {
+	fake, c := newTestClient(t)
+	prod := fake.AddServer("prod", "10.0.0.1", 8081)
+	staging := fake.AddServer("staging", "10.0.0.2", 8081)
+	roles := newResourceHarness(t, NewRoleResource(), c)
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	plan := rolePlan("deployers", "", inlinePermission(int64(staging.ID), "logs.read", "app-*"))
+	plan.PermissionSets = []PermissionSet{{
+		ServerIDs:   []types.Int64{types.Int64Value(int64(prod.ID))},
+		Permissions: []PermissionDefinition{{Name: types.StringValue("stacks.read"), Pattern: types.StringValue("*")}},
+	}}
+	var role RoleResourceModel
+	roles.get(roles.create(plan), &role)
+	roleID, err := strconv.ParseInt(role.ID.ValueString(), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := h.moveState("berth_role", roles, role)
+	requireDiagnostics(t, diags, "", "Only one permission rule moved")
+
+	var moved RolePermissionResourceModel
+	h.get(state, &moved)
+	target := RolePermissionResourceModel{
+		RoleID:         types.Int64Value(roleID),
+		ServerID:       types.Int64Value(int64(prod.ID)),
+		PermissionName: types.StringValue("stacks.read"),
+		StackPattern:   types.StringValue("*"),
+	}
+	if !moved.RoleID.Equal(target.RoleID) || !moved.ServerID.Equal(target.ServerID) || !moved.PermissionName.Equal(target.PermissionName) || !moved.StackPattern.Equal(target.StackPattern) {
+		t.Fatalf("expected the moved rule to match its target configuration without replacement, got %+v", moved)
+	}
+
+	var movedRuleID, otherRuleID int32
+	for _, rule := range fake.Rules(int32(roleID)) {
+		if rule.ServerID == prod.ID {
+			movedRuleID = rule.ID
+		} else {
+			otherRuleID = rule.ID
+		}
+	}
+	if moved.ID.ValueString() != strconv.Itoa(int(movedRuleID)) {
+		t.Fatalf("expected moved rule %d, got %s", movedRuleID, moved.ID)
+	}
+	if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, fmt.Sprintf("%d:%d", roleID, otherRuleID)) {
+		t.Fatalf("expected the warning to list the import ID of the remaining rule, got %q", detail)
+	}
+
+	_, diags = h.read(state)
+	requireNoDiags(t, diags)
+
+	var imported RolePermissionResourceModel
+	h.get(h.importState(fmt.Sprintf("%d:%d", roleID, otherRuleID)), &imported)
+	if imported.ServerID.ValueInt64() != int64(staging.ID) || imported.StackPattern.ValueString() != "app-*" {
+		t.Fatalf("unexpected imported rule: %+v", imported)
+	}
+	if len(fake.Rules(int32(roleID))) != 2 {
+		t.Fatalf("expected the move to keep every rule, got %+v", fake.Rules(int32(roleID)))
+	}
+}
+
+func TestRolePermissionResource_MoveFromRoleWithoutRules(t *testing.T) {
+	_, c := newTestClient(t)
+	roles := newResourceHarness(t, NewRoleResource(), c)
+	h := newResourceHarness(t, NewRolePermissionResource(), c)
+
+	var role RoleResourceModel
+	roles.get(roles.create(rolePlan("empty", "")), &role)
+
+	_, diags := h.moveState("berth_role", roles, role)
+	requireDiagnostics(t, diags, "Unable to move berth_role", "")
+}
+
+func TestRoleResource_MoveFromRolePermission(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	role := fake.AddRole("deployers", "Deploys stacks")
+	rule := fake.AddRule(role.ID, server.ID, "stacks.read", "*")
+	fake.AddRule(role.ID, server.ID, "logs.read", "*")
+	rules := newResourceHarness(t, NewRolePermissionResource(), c)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state, diags := h.moveState("berth_role_permission", rules, RolePermissionResourceModel{
+		ID:             types.StringValue(strconv.Itoa(int(rule.ID))),
+		RoleID:         types.Int64Value(int64(role.ID)),
+		ServerID:       types.Int64Value(int64(server.ID)),
+		ServerName:     types.StringNull(),
+		PermissionName: types.StringValue("stacks.read"),
+		StackPattern:   types.StringValue("*"),
+	})
+	requireNoDiags(t, diags)
+
+	var moved RoleResourceModel
+	h.get(state, &moved)
+	if moved.Name.ValueString() != "deployers" || moved.Description.ValueString() != "Deploys stacks" || moved.Builtin.ValueBool() {
+		t.Fatalf("expected name and description to be read from Berth, got %+v", moved)
+	}
+
+	state, diags = h.read(state)
+	requireNoDiags(t, diags)
+	h.get(state, &moved)
+	if len(moved.Permissions) != 2 {
+		t.Fatalf("expected refresh to pick up the role's other rules, got %+v", moved.Permissions)
+	}
+
+	plan := rolePlan("deployers", "Deploys stacks",
+		inlinePermission(int64(server.ID), "stacks.read", "*"),
+		inlinePermission(int64(server.ID), "logs.read", "*"),
+	)
+	plan.ID = moved.ID
+	h.update(state, plan)
+	if got := fake.Rules(role.ID); len(got) != 2 || got[0].ID != rule.ID {
+		t.Fatalf("expected the moved rules to be kept in place, got %+v", got)
+	}
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &RolePermissionsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &RolePermissionsDataSource{}
+
+func NewRolePermissionsDataSource() datasource.DataSource {
+	return &RolePermissionsDataSource{}
+}
+
+type RolePermissionsDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type RolePermissionsDataSourceModel struct {
+	ID       types.String              `tfsdk:"id"`
+	RoleID   types.Int64               `tfsdk:"role_id"`
+	RoleName types.String              `tfsdk:"role_name"`
+	Rules    []RolePermissionRuleModel `tfsdk:"rules"`
+}
+
+type RolePermissionRuleModel struct {
+	ID             types.String `tfsdk:"id"`
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	PermissionID   types.Int64  `tfsdk:"permission_id"`
+	PermissionName types.String `tfsdk:"permission_name"`
+	Resource       types.String `tfsdk:"resource"`
+	Action         types.String `tfsdk:"action"`
+	StackPattern   types.String `tfsdk:"stack_pattern"`
+}
+
+func (d *RolePermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_permissions"
+}
+
+func (d *RolePermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns the permission rules of a role, joined with their permission names",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"role_id": schema.Int64Attribute{
+				Description: "Role ID. Exactly one of role_id or role_name must be set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Description: "Role name. Exactly one of role_id or role_name must be set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Permission rules assigned to the role",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Permission rule ID",
+							Computed:    true,
+						},
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID",
+							Computed:    true,
+						},
+						"permission_id": schema.Int64Attribute{
+							Description: "Permission ID",
+							Computed:    true,
+						},
+						"permission_name": schema.StringAttribute{
+							Description: "Permission name",
+							Computed:    true,
+						},
+						"resource": schema.StringAttribute{
+							Description: "Resource the permission applies to",
+							Computed:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "Action the permission allows",
+							Computed:    true,
+						},
+						"stack_pattern": schema.StringAttribute{
+							Description: "Stack pattern",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolePermissionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data RolePermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleID.IsUnknown() || data.RoleName.IsUnknown() {
+		return
+	}
+
+	if data.RoleID.IsNull() == data.RoleName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("role_id"),
+			"Invalid role reference",
+			"Exactly one of role_id or role_name must be set.",
+		)
+	}
+}
+
+func (d *RolePermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *RolePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolePermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var role *client.Role
+	var err error
+	subject := fmt.Sprintf("Role %q", data.RoleName.ValueString())
+	if !data.RoleID.IsNull() {
+		subject = fmt.Sprintf("Role %d", data.RoleID.ValueInt64())
+		role, err = d.client.GetRole(ctx, uint(data.RoleID.ValueInt64()))
+	} else {
+		role, err = d.client.GetRoleByName(ctx, data.RoleName.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find role", apiErrorDetail(d.providerData, subject, err))
+		return
+	}
+
+	perms, allPermissions, err := d.client.ListRolePermissions(ctx, role.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", role.Name), err))
+		return
+	}
+
+	permMap := make(map[uint]client.Permission)
+	for _, p := range allPermissions {
+		permMap[p.ID] = p
+	}
+
+	data.Rules = make([]RolePermissionRuleModel, 0, len(perms))
+	for _, perm := range perms {
+		permission := permMap[perm.PermissionID]
+		data.Rules = append(data.Rules, RolePermissionRuleModel{
+			ID:             types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+			ServerID:       types.Int64Value(int64(perm.ServerID)),
+			PermissionID:   types.Int64Value(int64(perm.PermissionID)),
+			PermissionName: types.StringValue(permission.Name),
+			Resource:       types.StringValue(permission.Resource),
+			Action:         types.StringValue(permission.Action),
+			StackPattern:   types.StringValue(perm.StackPattern),
+		})
+	}
+
+	data.ID = types.StringValue(strconv.FormatUint(uint64(role.ID), 10))
+	data.RoleID = types.Int64Value(int64(role.ID))
+	data.RoleName = types.StringValue(role.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRolePermissionsDataSource_ByName(t *testing.T) {
+	fake, c := newTestClient(t)
+	role := fake.AddRole("deployers", "")
+	fake.AddRule(role.ID, 1, "stacks.read", "*")
+	fake.AddRule(role.ID, 2, "logs.read", "prod-*")
+
+	state, diags := readDataSource(t, NewRolePermissionsDataSource(), c, RolePermissionsDataSourceModel{
+		ID:       types.StringNull(),
+		RoleID:   types.Int64Null(),
+		RoleName: types.StringValue("deployers"),
+	})
+	requireNoDiags(t, diags)
+
+	var data RolePermissionsDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.RoleID.ValueInt64() != int64(role.ID) {
+		t.Fatalf("expected role_id %d, got %s", role.ID, data.RoleID)
+	}
+	if len(data.Rules) != 2 {
+		t.Fatalf("expected 2 rules, got %+v", data.Rules)
+	}
+	if rule := data.Rules[1]; rule.PermissionName.ValueString() != "logs.read" || rule.ServerID.ValueInt64() != 2 || rule.StackPattern.ValueString() != "prod-*" {
+		t.Fatalf("unexpected rule: %+v", rule)
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &RolePermissionsDiffFunction{}
+
+func NewRolePermissionsDiffFunction() function.Function {
+	return &RolePermissionsDiffFunction{}
+}
+
+type RolePermissionsDiffFunction struct{}
+
+type RolePermissionsDiffResult struct {
+	Added     []EffectiveRule `tfsdk:"added"`
+	Removed   []EffectiveRule `tfsdk:"removed"`
+	Unchanged []EffectiveRule `tfsdk:"unchanged"`
+}
+
+func (f *RolePermissionsDiffFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "role_permissions_diff"
+}
+
+func (f *RolePermissionsDiffFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	ruleType := types.ObjectType{AttrTypes: effectiveRuleAttrTypes}
+	rulesType := types.ListType{ElemType: ruleType}
+
+	resp.Definition = function.Definition{
+		Summary:     "Compare two lists of role permission rules",
+		Description: "Returns the rules added, removed and unchanged between two lists of {server_id, permission_name, stack_pattern} objects, such as berth_role.effective_rules. A null stack_pattern is treated as '*'.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "old_rules",
+				Description: "Rules before the change",
+				ElementType: ruleType,
+			},
+			function.ListParameter{
+				Name:        "new_rules",
+				Description: "Rules after the change",
+				ElementType: ruleType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"added":     rulesType,
+				"removed":   rulesType,
+				"unchanged": rulesType,
+			},
+		},
+	}
+}
+
+func (f *RolePermissionsDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var oldRules, newRules []EffectiveRule
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &oldRules, &newRules))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, diffRules(oldRules, newRules)))
+}
+
+func diffRules(oldRules, newRules []EffectiveRule) RolePermissionsDiffResult {
+	type ruleKey struct {
+		serverID       int64
+		permissionName string
+		stackPattern   string
+	}
+
+	keyOf := func(rule EffectiveRule) ruleKey {
+		stackPattern := "*"
+		if !rule.StackPattern.IsNull() && !rule.StackPattern.IsUnknown() {
+			stackPattern = rule.StackPattern.ValueString()
+		}
+		return ruleKey{rule.ServerID.ValueInt64(), rule.PermissionName.ValueString(), stackPattern}
+	}
+
+	toRule := func(key ruleKey) EffectiveRule {
+		return EffectiveRule{
+			ServerID:       types.Int64Value(key.serverID),
+			PermissionName: types.StringValue(key.permissionName),
+			StackPattern:   types.StringValue(key.stackPattern),
+		}
+	}
+
+	oldKeys := make(map[ruleKey]bool, len(oldRules))
+	for _, rule := range oldRules {
+		oldKeys[keyOf(rule)] = true
+	}
+
+	newKeys := make(map[ruleKey]bool, len(newRules))
+	for _, rule := range newRules {
+		newKeys[keyOf(rule)] = true
+	}
+
+	result := RolePermissionsDiffResult{
+		Added:     []EffectiveRule{},
+		Removed:   []EffectiveRule{},
+		Unchanged: []EffectiveRule{},
+	}
+
+	seen := make(map[ruleKey]bool)
+	for _, rule := range newRules {
+		key := keyOf(rule)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if oldKeys[key] {
+			result.Unchanged = append(result.Unchanged, toRule(key))
+		} else {
+			result.Added = append(result.Added, toRule(key))
+		}
+	}
+
+	for _, rule := range oldRules {
+		key := keyOf(rule)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if !newKeys[key] {
+			result.Removed = append(result.Removed, toRule(key))
+		}
+	}
+
+	return result
+}
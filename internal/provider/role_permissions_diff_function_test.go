@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func effectiveRule(serverID int64, name string, pattern types.String) EffectiveRule {
+	return EffectiveRule{
+		ServerID:       types.Int64Value(serverID),
+		PermissionName: types.StringValue(name),
+		StackPattern:   pattern,
+	}
+}
+
+func ruleStrings(rules []EffectiveRule) []string {
+	out := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, fmt.Sprintf("%d:%s:%s", rule.ServerID.ValueInt64(), rule.PermissionName.ValueString(), rule.StackPattern.ValueString()))
+	}
+	return out
+}
+
+func TestDiffRules(t *testing.T) {
+	readAll := effectiveRule(1, "stacks.read", types.StringValue("*"))
+	readAllNull := effectiveRule(1, "stacks.read", types.StringNull())
+	readAllUnknown := effectiveRule(1, "stacks.read", types.StringUnknown())
+	readApp := effectiveRule(1, "stacks.read", types.StringValue("app-*"))
+	manage := effectiveRule(2, "stacks.manage", types.StringValue("*"))
+	logs := effectiveRule(3, "logs.read", types.StringValue("*"))
+
+	tests := []struct {
+		name          string
+		oldRules      []EffectiveRule
+		newRules      []EffectiveRule
+		wantAdded     []string
+		wantRemoved   []string
+		wantUnchanged []string
+	}{
+		{
+			name:          "both empty",
+			wantAdded:     []string{},
+			wantRemoved:   []string{},
+			wantUnchanged: []string{},
+		},
+		{
+			name:          "all added",
+			newRules:      []EffectiveRule{readAll, manage},
+			wantAdded:     []string{"1:stacks.read:*", "2:stacks.manage:*"},
+			wantRemoved:   []string{},
+			wantUnchanged: []string{},
+		},
+		{
+			name:          "all removed",
+			oldRules:      []EffectiveRule{readAll, manage},
+			wantAdded:     []string{},
+			wantRemoved:   []string{"1:stacks.read:*", "2:stacks.manage:*"},
+			wantUnchanged: []string{},
+		},
+		{
+			name:          "added removed and unchanged",
+			oldRules:      []EffectiveRule{readAll, manage},
+			newRules:      []EffectiveRule{manage, logs},
+			wantAdded:     []string{"3:logs.read:*"},
+			wantRemoved:   []string{"1:stacks.read:*"},
+			wantUnchanged: []string{"2:stacks.manage:*"},
+		},
+		{
+			name:          "null stack pattern matches star",
+			oldRules:      []EffectiveRule{readAllNull},
+			newRules:      []EffectiveRule{readAll},
+			wantAdded:     []string{},
+			wantRemoved:   []string{},
+			wantUnchanged: []string{"1:stacks.read:*"},
+		},
+		{
+			name:          "unknown stack pattern matches star",
+			oldRules:      []EffectiveRule{readAll},
+			newRules:      []EffectiveRule{readAllUnknown},
+			wantAdded:     []string{},
+			wantRemoved:   []string{},
+			wantUnchanged: []string{"1:stacks.read:*"},
+		},
+		{
+			name:          "stack pattern distinguishes rules",
+			oldRules:      []EffectiveRule{readAll},
+			newRules:      []EffectiveRule{readApp},
+			wantAdded:     []string{"1:stacks.read:app-*"},
+			wantRemoved:   []string{"1:stacks.read:*"},
+			wantUnchanged: []string{},
+		},
+		{
+			name:          "duplicates are reported once",
+			oldRules:      []EffectiveRule{manage, manage, readAll, readAllNull},
+			newRules:      []EffectiveRule{logs, logs, manage, manage},
+			wantAdded:     []string{"3:logs.read:*"},
+			wantRemoved:   []string{"1:stacks.read:*"},
+			wantUnchanged: []string{"2:stacks.manage:*"},
+		},
+		{
+			name:          "added and unchanged follow new order and removed follows old order",
+			oldRules:      []EffectiveRule{logs, readApp, manage, readAll},
+			newRules:      []EffectiveRule{manage, readAll, effectiveRule(4, "logs.read", types.StringValue("*")), effectiveRule(0, "stacks.read", types.StringValue("*"))},
+			wantAdded:     []string{"4:logs.read:*", "0:stacks.read:*"},
+			wantRemoved:   []string{"3:logs.read:*", "1:stacks.read:app-*"},
+			wantUnchanged: []string{"2:stacks.manage:*", "1:stacks.read:*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := diffRules(tt.oldRules, tt.newRules)
+
+			if got := ruleStrings(result.Added); !reflect.DeepEqual(got, tt.wantAdded) {
+				t.Errorf("added: expected %v, got %v", tt.wantAdded, got)
+			}
+			if got := ruleStrings(result.Removed); !reflect.DeepEqual(got, tt.wantRemoved) {
+				t.Errorf("removed: expected %v, got %v", tt.wantRemoved, got)
+			}
+			if got := ruleStrings(result.Unchanged); !reflect.DeepEqual(got, tt.wantUnchanged) {
+				t.Errorf("unchanged: expected %v, got %v", tt.wantUnchanged, got)
+			}
+		})
+	}
+}
+
+func TestDiffRules_ResultListsAreNeverNull(t *testing.T) {
+	result := diffRules(nil, nil)
+	if result.Added == nil || result.Removed == nil || result.Unchanged == nil {
+		t.Fatalf("expected empty lists rather than null, got %+v", result)
+	}
+}
@@ -3,39 +3,56 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
 )
 
 var _ resource.Resource = &RoleResource{}
 var _ resource.ResourceWithImportState = &RoleResource{}
+var _ resource.ResourceWithMoveState = &RoleResource{}
+var _ resource.ResourceWithModifyPlan = &RoleResource{}
+var _ resource.ResourceWithValidateConfig = &RoleResource{}
 
 func NewRoleResource() resource.Resource {
 	return &RoleResource{}
 }
 
 type RoleResource struct {
-	client *client.Client
+	client       client.BerthAPI
+	providerData *ProviderData
 }
 
 type RoleResourceModel struct {
-	ID             types.String           `tfsdk:"id"`
-	Name           types.String           `tfsdk:"name"`
-	Description    types.String           `tfsdk:"description"`
-	Permissions    []RolePermissionInline `tfsdk:"permissions"`
-	PermissionSets []PermissionSet        `tfsdk:"permission_set"`
+	ID                      types.String           `tfsdk:"id"`
+	Name                    types.String           `tfsdk:"name"`
+	Description             types.String           `tfsdk:"description"`
+	ManageAllPermissions    types.Bool             `tfsdk:"manage_all_permissions"`
+	Builtin                 types.Bool             `tfsdk:"builtin"`
+	AllowBuiltinRoleChanges types.Bool             `tfsdk:"allow_builtin_role_changes"`
+	Permissions             []RolePermissionInline `tfsdk:"permissions"`
+	PermissionSets          []PermissionSet        `tfsdk:"permission_set"`
+	EffectiveRules          types.List             `tfsdk:"effective_rules"`
 }
 
 type RolePermissionInline struct {
 	ID             types.String `tfsdk:"id"`
 	ServerID       types.Int64  `tfsdk:"server_id"`
+	ServerName     types.String `tfsdk:"server_name"`
 	PermissionName types.String `tfsdk:"permission_name"`
 	StackPattern   types.String `tfsdk:"stack_pattern"`
 }
@@ -50,13 +67,26 @@ type PermissionDefinition struct {
 	Pattern types.String `tfsdk:"pattern"`
 }
 
+type EffectiveRule struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	PermissionName types.String `tfsdk:"permission_name"`
+	StackPattern   types.String `tfsdk:"stack_pattern"`
+}
+
+var effectiveRuleAttrTypes = map[string]attr.Type{
+	"server_id":       types.Int64Type,
+	"permission_name": types.StringType,
+	"stack_pattern":   types.StringType,
+}
+
 func (r *RoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_role"
 }
 
 func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Berth role with optional inline permissions",
+		Description: "Manages a Berth role with optional inline permissions. If a permission rule fails to be created, the role and the rules created before the failure are saved to state and Terraform marks the role as tainted. " +
+			"Run terraform untaint on the role before the next apply to keep it and only create the missing rules; otherwise the role is deleted and recreated with all of its rules",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Role ID",
@@ -73,10 +103,50 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Description: "Role description",
 				Optional:    true,
 			},
+			"manage_all_permissions": schema.BoolAttribute{
+				Description: "Whether the configured permissions and permission_set blocks are the complete list of the role's permission rules. When true, every other rule, including those from berth_role_permission, shows up as drift and is deleted on apply. When false, only rules this resource declared are touched. When unset, the configured blocks are authoritative if the role declares any, and the role's rules are left alone if it declares none",
+				Optional:    true,
+			},
+			"builtin": schema.BoolAttribute{
+				Description: "Whether the role is a built-in Berth admin role. Built-in roles cannot be renamed or deleted unless allow_builtin_role_changes is true",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_builtin_role_changes": schema.BoolAttribute{
+				Description: "Allow renaming or deleting a built-in role. To delete one, set this to true and apply before removing the resource. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"effective_rules": schema.ListNestedAttribute{
+				Description: "Expanded, deduplicated union of inline permissions and permission_set entries that this role grants",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.Int64Attribute{
+							Description: "Server ID",
+							Computed:    true,
+						},
+						"permission_name": schema.StringAttribute{
+							Description: "Permission name",
+							Computed:    true,
+						},
+						"stack_pattern": schema.StringAttribute{
+							Description: "Stack name pattern",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 		Blocks: map[string]schema.Block{
-			"permissions": schema.ListNestedBlock{
-				Description: "Inline permissions for this role (use permission_set for bulk assignment to multiple servers)",
+			"permissions": schema.SetNestedBlock{
+				Description: "Inline permissions for this role (use permission_set for bulk assignment to multiple servers). Order does not matter",
+				PlanModifiers: []planmodifier.Set{
+					inlinePermissionIDsModifier{},
+				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
@@ -84,43 +154,57 @@ func (r *RoleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 							Computed:    true,
 						},
 						"server_id": schema.Int64Attribute{
-							Description: "Server ID",
-							Required:    true,
+							Description: "Server ID. Exactly one of server_id or server_name must be set",
+							Optional:    true,
+							Computed:    true,
+						},
+						"server_name": schema.StringAttribute{
+							Description: "Server name, resolved to server_id through the Berth API",
+							Optional:    true,
 						},
 						"permission_name": schema.StringAttribute{
 							Description: "Permission name (e.g., 'stacks.read', 'stacks.manage', 'files.read', 'files.write', 'logs.read')",
 							Required:    true,
+							Validators: []validator.String{
+								permissionNameValidator{},
+							},
 						},
 						"stack_pattern": schema.StringAttribute{
 							Description: "Stack name pattern (supports wildcards, e.g., '*', 'prod-*'). Defaults to '*'",
 							Optional:    true,
 							Computed:    true,
+							Default:     stringdefault.StaticString(defaultStackPattern),
 						},
 					},
 				},
 			},
-			"permission_set": schema.ListNestedBlock{
-				Description: "Permission sets - apply multiple permissions to multiple servers at once",
+			"permission_set": schema.SetNestedBlock{
+				Description: "Permission sets - apply multiple permissions to multiple servers at once. Order does not matter",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
-						"server_ids": schema.ListAttribute{
+						"server_ids": schema.SetAttribute{
 							Description: "List of server IDs to apply these permissions to",
 							Required:    true,
 							ElementType: types.Int64Type,
 						},
 					},
 					Blocks: map[string]schema.Block{
-						"permissions": schema.ListNestedBlock{
+						"permissions": schema.SetNestedBlock{
 							Description: "List of permissions to apply",
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"name": schema.StringAttribute{
 										Description: "Permission name (e.g., 'stacks.read', 'stacks.manage')",
 										Required:    true,
+										Validators: []validator.String{
+											permissionNameValidator{},
+										},
 									},
 									"pattern": schema.StringAttribute{
 										Description: "Stack pattern. Defaults to '*'",
 										Optional:    true,
+										Computed:    true,
+										Default:     stringdefault.StaticString(defaultStackPattern),
 									},
 								},
 							},
@@ -137,101 +221,351 @@ func (r *RoleResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	providerData, ok := req.ProviderData.(*ProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *RoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var permissions types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, elem := range permissions.Elements() {
+		object, ok := elem.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		serverID, _ := object.Attributes()["server_id"].(types.Int64)
+		serverName, _ := object.Attributes()["server_name"].(types.String)
+		resp.Diagnostics.Append(validateServerReference(path.Root("permissions").AtSetValue(elem).AtName("server_id"), serverID, serverName)...)
+	}
+}
+
+func (r *RoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(checkBuiltinRoleChange(ctx, req.State, req.Plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.planServerNames(ctx, req.State, &resp.Plan)...)
+	resp.Diagnostics.Append(planEffectiveRules(ctx, &resp.Plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, diags := plannedRolePermissionNames(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkPermissionsSupported(ctx, r.providerData, names)...)
+
+	serverIDs, diags := plannedRoleServerIDs(ctx, resp.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkServersExist(ctx, r.providerData, serverIDs)...)
+}
+
+func (r *RoleResource) planServerNames(ctx context.Context, state tfsdk.State, plan *tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var planned types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root("permissions"), &planned)...)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+
+	var perms []RolePermissionInline
+	diags.Append(planned.ElementsAs(ctx, &perms, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	named := false
+	for i, perm := range perms {
+		if !perm.ServerName.IsNull() {
+			perms[i].ServerID = types.Int64Unknown()
+			perms[i].ID = types.StringUnknown()
+			named = true
+		}
+	}
+	if !named {
+		return diags
+	}
+
+	if r.client != nil {
+		diags.Append(r.resolveServerNames(ctx, perms)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !state.Raw.IsNull() {
+		var prior []RolePermissionInline
+		diags.Append(state.GetAttribute(ctx, path.Root("permissions"), &prior)...)
+		keepInlinePermissionIDs(perms, prior)
+	}
+
+	value, d := types.SetValueFrom(ctx, planned.ElementType(ctx), perms)
+	diags.Append(d...)
+	diags.Append(plan.SetAttribute(ctx, path.Root("permissions"), value)...)
+	return diags
+}
+
+func planEffectiveRules(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var data RoleResourceModel
+	if plan.Get(ctx, &data).HasError() || !data.declaredRulesKnown() {
+		return nil
+	}
+
+	diags := data.setEffectiveRules(ctx)
+	diags.Append(plan.SetAttribute(ctx, path.Root("effective_rules"), data.EffectiveRules)...)
+	return diags
+}
+
+func (r *RoleResource) resolveServerNames(ctx context.Context, perms []RolePermissionInline) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var ids map[string]int64
+
+	for i, perm := range perms {
+		if !perm.ServerID.IsUnknown() || perm.ServerName.IsNull() || perm.ServerName.IsUnknown() {
+			continue
+		}
+
+		if ids == nil {
+			var err error
+			ids, err = serverIDsByName(ctx, r.client)
+			if err != nil {
+				diags.AddError("Failed to list servers", apiErrorDetail(r.providerData, "Servers", err))
+				return diags
+			}
+		}
+
+		id, ok := ids[perm.ServerName.ValueString()]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("permissions"),
+				"Failed to find server",
+				fmt.Sprintf("Berth has no server named %q.", perm.ServerName.ValueString()),
+			)
+			continue
+		}
+		perms[i].ServerID = types.Int64Value(id)
+	}
+
+	return diags
+}
+
+func checkBuiltinRoleChange(ctx context.Context, state tfsdk.State, plan tfsdk.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var builtin, allowed types.Bool
+	var name types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("builtin"), &builtin)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("allow_builtin_role_changes"), &allowed)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("name"), &name)...)
+	if diags.HasError() || !builtin.ValueBool() {
+		return diags
+	}
+
+	if plan.Raw.IsNull() {
+		if !allowed.ValueBool() {
+			diags.AddError(
+				"Cannot delete built-in role",
+				fmt.Sprintf("Role %q is a built-in Berth admin role. To delete it, set allow_builtin_role_changes = true and apply before removing the resource. To stop managing it without deleting it, use a removed block with destroy = false.", name.ValueString()),
+			)
+		}
+		return diags
+	}
+
+	var plannedName types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("name"), &plannedName)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("allow_builtin_role_changes"), &allowed)...)
+	if diags.HasError() || allowed.ValueBool() || plannedName.IsUnknown() || plannedName.Equal(name) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path.Root("name"),
+		"Cannot rename built-in role",
+		fmt.Sprintf("Role %q is a built-in Berth admin role. Set allow_builtin_role_changes = true to rename it.", name.ValueString()),
+	)
+	return diags
 }
 
 func (r *RoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RoleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(r.resolveServerNames(ctx, data.Permissions)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	role, err := r.client.CreateRole(data.Name.ValueString(), data.Description.ValueString())
+	role, err := r.client.CreateRole(ctx, data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to create role", err.Error())
+		resp.Diagnostics.AddError("Failed to create role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %q", data.Name.ValueString()), err))
 		return
 	}
 
 	data.ID = types.StringValue(strconv.FormatUint(uint64(role.ID), 10))
+	data.Builtin = types.BoolValue(role.IsAdmin)
+
+	resp.Diagnostics.Append(r.createPermissions(ctx, role.ID, &data)...)
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(r.savePartialState(ctx, role.ID, data, &resp.State)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setEffectiveRules(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type pendingRule struct {
+	summary      string
+	subject      string
+	serverID     uint
+	permissionID uint
+	stackPattern string
+}
+
+func (r *RoleResource) createPermissions(ctx context.Context, roleID uint, data *RoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rules := make([]pendingRule, 0)
+	addRule := func(summary string, serverID int64, name string, pattern types.String) bool {
+		permission, err := r.client.GetPermissionByName(ctx, name)
+		if err != nil {
+			diags.AddError("Failed to find permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission %q", name), err))
+			return false
+		}
+
+		rules = append(rules, pendingRule{
+			summary:      summary,
+			subject:      fmt.Sprintf("Permission %q on server %d for role %d", name, serverID, roleID),
+			serverID:     uint(serverID),
+			permissionID: permission.ID,
+			stackPattern: stackPatternValue(pattern),
+		})
+		return true
+	}
 
 	for _, permSet := range data.PermissionSets {
 		for _, serverID := range permSet.ServerIDs {
 			for _, perm := range permSet.Permissions {
-				stackPattern := "*"
-				if !perm.Pattern.IsNull() && !perm.Pattern.IsUnknown() {
-					stackPattern = perm.Pattern.ValueString()
-				}
-
-				permission, err := r.client.GetPermissionByName(perm.Name.ValueString())
-				if err != nil {
-					resp.Diagnostics.AddError("Failed to find permission", err.Error())
-					return
-				}
-
-				_, err = r.client.CreateRolePermission(
-					role.ID,
-					uint(serverID.ValueInt64()),
-					permission.ID,
-					stackPattern,
-				)
-				if err != nil {
-					resp.Diagnostics.AddError("Failed to create role permission from permission set", err.Error())
-					return
+				if !addRule("Failed to create role permission from permission set", serverID.ValueInt64(), perm.Name.ValueString(), perm.Pattern) {
+					return diags
 				}
 			}
 		}
 	}
 
-	for i, perm := range data.Permissions {
-		stackPattern := "*"
-		if !perm.StackPattern.IsNull() && !perm.StackPattern.IsUnknown() {
-			stackPattern = perm.StackPattern.ValueString()
+	inlineOffset := len(rules)
+	for _, perm := range data.Permissions {
+		if !addRule("Failed to create role permission", perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern) {
+			return diags
 		}
+	}
+
+	ruleIDs, createDiags := r.createRules(ctx, roleID, rules)
+	diags.Append(createDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i := range data.Permissions {
+		rule := rules[inlineOffset+i]
+		data.Permissions[i].ID = types.StringValue(strconv.FormatUint(uint64(ruleIDs[inlineOffset+i]), 10))
+		data.Permissions[i].StackPattern = types.StringValue(rule.stackPattern)
+	}
 
-		permission, err := r.client.GetPermissionByName(perm.PermissionName.ValueString())
+	return diags
+}
+
+func (r *RoleResource) createRules(ctx context.Context, roleID uint, rules []pendingRule) ([]uint, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ruleIDs := make([]uint, len(rules))
+	errs := runConcurrently(r.providerData.RuleConcurrency, len(rules), func(i int) error {
+		rule := rules[i]
+		created, err := r.client.CreateRolePermission(ctx, roleID, rule.serverID, rule.permissionID, rule.stackPattern)
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to find permission", err.Error())
-			return
+			return err
 		}
+		ruleIDs[i] = created.ID
+		return nil
+	})
 
-		createdPerm, err := r.client.CreateRolePermission(
-			role.ID,
-			uint(perm.ServerID.ValueInt64()),
-			permission.ID,
-			stackPattern,
-		)
+	for i, err := range errs {
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to create role permission", err.Error())
-			return
+			diags.AddError(rules[i].summary, apiErrorDetail(r.providerData, rules[i].subject, err))
 		}
+	}
 
-		perms, _, err := r.client.ListRolePermissions(role.ID)
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to read created permission", err.Error())
-			return
+	return ruleIDs, diags
+}
+
+func (r *RoleResource) deleteRules(ctx context.Context, roleID uint, perms []client.RolePermission) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	errs := runConcurrently(r.providerData.RuleConcurrency, len(perms), func(i int) error {
+		err := r.client.DeleteRolePermission(ctx, roleID, perms[i].ID)
+		if client.IsNotFound(err) {
+			return nil
 		}
+		return err
+	})
 
-		for _, p := range perms {
-			if p.ServerID == createdPerm.ServerID && p.PermissionID == permission.ID && p.StackPattern == stackPattern {
-				data.Permissions[i].ID = types.StringValue(strconv.FormatUint(uint64(p.ID), 10))
-				data.Permissions[i].StackPattern = types.StringValue(stackPattern)
-				break
-			}
+	for i, err := range errs {
+		if err != nil {
+			diags.AddError("Failed to delete permission", apiErrorDetail(r.providerData, fmt.Sprintf("Permission rule %d of role %d", perms[i].ID, roleID), err))
 		}
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return diags
+}
+
+func (r *RoleResource) savePartialState(ctx context.Context, roleID uint, data RoleResourceModel, state *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	perms, allPermissions, err := r.client.ListRolePermissions(ctx, roleID)
+	if err != nil {
+		data.Permissions = nil
+		data.PermissionSets = nil
+	} else {
+		data.refreshPermissions(perms, allPermissions)
+	}
+
+	diags.Append(data.setEffectiveRules(ctx)...)
+	diags.Append(state.Set(ctx, &data)...)
+	diags.AddWarning(
+		"Role partially created",
+		fmt.Sprintf("Role %d and the permission rules created before the error were saved to state, so they are not orphaned. "+
+			"Terraform marks the role as tainted and deletes and recreates it on the next apply. "+
+			"To resume instead, fix the cause of the error and run terraform untaint on this resource; the next apply then only creates the missing rules.", roleID),
+	)
+	return diags
 }
 
 func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -248,39 +582,35 @@ func (r *RoleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	role, err := r.client.GetRole(uint(id))
+	role, err := r.client.GetRole(ctx, uint(id))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read role", err.Error())
+		resp.Diagnostics.AddError("Failed to read role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", id), err))
 		return
 	}
 
 	data.Name = types.StringValue(role.Name)
 	data.Description = types.StringValue(role.Description)
+	data.Builtin = types.BoolValue(role.IsAdmin)
 
-	if len(data.Permissions) > 0 {
-		perms, allPermissions, err := r.client.ListRolePermissions(uint(id))
+	if data.AllowBuiltinRoleChanges.IsNull() {
+		data.AllowBuiltinRoleChanges = types.BoolValue(false)
+	}
+
+	if len(data.Permissions) > 0 || len(data.PermissionSets) > 0 || data.ManageAllPermissions.ValueBool() {
+		perms, allPermissions, err := r.client.ListRolePermissions(ctx, uint(id))
 		if err != nil {
-			resp.Diagnostics.AddError("Failed to read role permissions", err.Error())
+			resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", id), err))
 			return
 		}
 
-		permMap := make(map[uint]string)
-		for _, p := range allPermissions {
-			permMap[p.ID] = p.Name
-		}
-
-		updatedPerms := make([]RolePermissionInline, 0, len(perms))
-		for _, perm := range perms {
-			updatedPerms = append(updatedPerms, RolePermissionInline{
-				ID:             types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
-				ServerID:       types.Int64Value(int64(perm.ServerID)),
-				PermissionName: types.StringValue(permMap[perm.PermissionID]),
-				StackPattern:   types.StringValue(perm.StackPattern),
-			})
-		}
-		data.Permissions = updatedPerms
+		data.refreshPermissions(perms, allPermissions)
 	}
 
+	resp.Diagnostics.Append(data.setEffectiveRules(ctx)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -293,6 +623,11 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.resolveServerNames(ctx, data.Permissions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid role ID", err.Error())
@@ -301,95 +636,147 @@ func (r *RoleResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	roleID := uint(id)
 
-	_, err = r.client.UpdateRole(roleID, data.Name.ValueString(), data.Description.ValueString())
+	_, err = r.client.UpdateRole(ctx, roleID, data.Name.ValueString(), data.Description.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to update role", err.Error())
+		resp.Diagnostics.AddError("Failed to update role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", roleID), err))
 		return
 	}
 
-	if len(data.Permissions) > 0 || len(state.Permissions) > 0 || len(data.PermissionSets) > 0 || len(state.PermissionSets) > 0 {
-
-		existingPerms, _, err := r.client.ListRolePermissions(roleID)
-		if err != nil {
-			resp.Diagnostics.AddError("Failed to read existing permissions", err.Error())
+	if len(data.Permissions) > 0 || len(state.Permissions) > 0 || len(data.PermissionSets) > 0 || len(state.PermissionSets) > 0 || data.ManageAllPermissions.ValueBool() {
+		resp.Diagnostics.Append(r.reconcilePermissions(ctx, roleID, &data, &state)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
 
-		for _, perm := range existingPerms {
-			if err := r.client.DeleteRolePermission(roleID, perm.ID); err != nil {
-				resp.Diagnostics.AddError("Failed to delete permission", err.Error())
-				return
-			}
+	resp.Diagnostics.Append(data.setEffectiveRules(ctx)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleResource) reconcilePermissions(ctx context.Context, roleID uint, data, state *RoleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	type ruleKey struct {
+		serverID     uint
+		permissionID uint
+		stackPattern string
+	}
+
+	allPermissions, err := r.client.ListPermissions(ctx)
+	if err != nil {
+		diags.AddError("Failed to list permissions", apiErrorDetail(r.providerData, "Permissions", err))
+		return diags
+	}
+
+	permissionIDs := make(map[string]uint, len(allPermissions))
+	permissionNames := make(map[uint]string, len(allPermissions))
+	for _, p := range allPermissions {
+		permissionIDs[p.Name] = p.ID
+		permissionNames[p.ID] = p.Name
+	}
+
+	managed := make(map[roleRuleKey]bool)
+	for _, key := range state.declaredRuleKeys() {
+		managed[key] = true
+	}
+	exclusive := data.exclusivePermissions()
+
+	keyFor := func(serverID int64, permissionName string, pattern types.String) (ruleKey, bool) {
+		permissionID, ok := permissionIDs[permissionName]
+		if !ok {
+			diags.AddError("Failed to find permission", client.UnknownPermissionError(permissionName, allPermissions).Error())
+			return ruleKey{}, false
 		}
 
-		for _, permSet := range data.PermissionSets {
-			for _, serverID := range permSet.ServerIDs {
-				for _, perm := range permSet.Permissions {
-					stackPattern := "*"
-					if !perm.Pattern.IsNull() && !perm.Pattern.IsUnknown() {
-						stackPattern = perm.Pattern.ValueString()
-					}
+		return ruleKey{uint(serverID), permissionID, stackPatternValue(pattern)}, true
+	}
 
-					permission, err := r.client.GetPermissionByName(perm.Name.ValueString())
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to find permission", err.Error())
-						return
-					}
+	desired := make(map[ruleKey]bool)
+	desiredKeys := make([]ruleKey, 0)
+	addDesired := func(key ruleKey) {
+		if !desired[key] {
+			desired[key] = true
+			desiredKeys = append(desiredKeys, key)
+		}
+	}
 
-					_, err = r.client.CreateRolePermission(
-						roleID,
-						uint(serverID.ValueInt64()),
-						permission.ID,
-						stackPattern,
-					)
-					if err != nil {
-						resp.Diagnostics.AddError("Failed to create role permission from permission set", err.Error())
-						return
-					}
+	for _, permSet := range data.PermissionSets {
+		for _, serverID := range permSet.ServerIDs {
+			for _, perm := range permSet.Permissions {
+				key, ok := keyFor(serverID.ValueInt64(), perm.Name.ValueString(), perm.Pattern)
+				if !ok {
+					return diags
 				}
+				addDesired(key)
 			}
 		}
+	}
 
-		for i, perm := range data.Permissions {
-			stackPattern := "*"
-			if !perm.StackPattern.IsNull() && !perm.StackPattern.IsUnknown() {
-				stackPattern = perm.StackPattern.ValueString()
-			}
-
-			permission, err := r.client.GetPermissionByName(perm.PermissionName.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to find permission", err.Error())
-				return
-			}
+	inlineKeys := make([]ruleKey, len(data.Permissions))
+	for i, perm := range data.Permissions {
+		key, ok := keyFor(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern)
+		if !ok {
+			return diags
+		}
+		inlineKeys[i] = key
+		addDesired(key)
+	}
 
-			createdPerm, err := r.client.CreateRolePermission(
-				roleID,
-				uint(perm.ServerID.ValueInt64()),
-				permission.ID,
-				stackPattern,
-			)
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to create role permission", err.Error())
-				return
-			}
+	existingPerms, _, err := r.client.ListRolePermissions(ctx, roleID)
+	if err != nil {
+		diags.AddError("Failed to read existing permissions", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", roleID), err))
+		return diags
+	}
 
-			perms, _, err := r.client.ListRolePermissions(roleID)
-			if err != nil {
-				resp.Diagnostics.AddError("Failed to read created permission", err.Error())
-				return
-			}
+	ruleIDs := make(map[ruleKey]uint)
+	stale := make([]client.RolePermission, 0)
+	for _, perm := range existingPerms {
+		key := ruleKey{perm.ServerID, perm.PermissionID, normalizeStackPattern(perm.StackPattern)}
+		if _, kept := ruleIDs[key]; desired[key] && !kept {
+			ruleIDs[key] = perm.ID
+			continue
+		}
+		if exclusive || managed[roleRuleKey{int64(perm.ServerID), permissionNames[perm.PermissionID], normalizeStackPattern(perm.StackPattern)}] {
+			stale = append(stale, perm)
+		}
+	}
 
-			for _, p := range perms {
-				if p.ServerID == createdPerm.ServerID && p.PermissionID == permission.ID && p.StackPattern == stackPattern {
-					data.Permissions[i].ID = types.StringValue(strconv.FormatUint(uint64(p.ID), 10))
-					data.Permissions[i].StackPattern = types.StringValue(stackPattern)
-					break
-				}
-			}
+	missing := make([]ruleKey, 0)
+	pending := make([]pendingRule, 0)
+	for _, key := range desiredKeys {
+		if _, exists := ruleIDs[key]; exists {
+			continue
 		}
+		missing = append(missing, key)
+		pending = append(pending, pendingRule{
+			summary:      "Failed to create role permission",
+			subject:      fmt.Sprintf("Permission %q on server %d for role %d", permissionNames[key.permissionID], key.serverID, roleID),
+			serverID:     key.serverID,
+			permissionID: key.permissionID,
+			stackPattern: key.stackPattern,
+		})
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	createdIDs, createDiags := r.createRules(ctx, roleID, pending)
+	diags.Append(createDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	for i, key := range missing {
+		ruleIDs[key] = createdIDs[i]
+	}
+
+	diags.Append(r.deleteRules(ctx, roleID, stale)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, key := range inlineKeys {
+		data.Permissions[i].ID = types.StringValue(strconv.FormatUint(uint64(ruleIDs[key]), 10))
+		data.Permissions[i].StackPattern = types.StringValue(key.stackPattern)
+	}
+
+	return diags
 }
 
 func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -406,8 +793,16 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	if err := r.client.DeleteRole(uint(id)); err != nil {
-		resp.Diagnostics.AddError("Failed to delete role", err.Error())
+	if data.Builtin.ValueBool() && !data.AllowBuiltinRoleChanges.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cannot delete built-in role",
+			fmt.Sprintf("Role %q is a built-in Berth admin role. Set allow_builtin_role_changes = true and apply before deleting it.", data.Name.ValueString()),
+		)
+		return
+	}
+
+	if err := r.client.DeleteRole(ctx, uint(id)); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", id), err))
 		return
 	}
 }
@@ -415,3 +810,259 @@ func (r *RoleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func (r *RoleResource) MoveState(ctx context.Context) []resource.StateMover {
+	var sourceSchema resource.SchemaResponse
+	(&RolePermissionResource{}).Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "berth_role_permission" || !isBerthProviderAddress(req.SourceProviderAddress) || req.SourceState == nil {
+					return
+				}
+
+				var source RolePermissionResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := RoleResourceModel{
+					ID:                      types.StringValue(strconv.FormatInt(source.RoleID.ValueInt64(), 10)),
+					Name:                    types.StringNull(),
+					Description:             types.StringNull(),
+					ManageAllPermissions:    types.BoolNull(),
+					Builtin:                 types.BoolValue(false),
+					AllowBuiltinRoleChanges: types.BoolValue(false),
+					Permissions: []RolePermissionInline{
+						{
+							ID:             source.ID,
+							ServerID:       source.ServerID,
+							ServerName:     source.ServerName,
+							PermissionName: source.PermissionName,
+							StackPattern:   source.StackPattern,
+						},
+					},
+				}
+
+				if r.client != nil {
+					role, err := r.client.GetRole(ctx, uint(source.RoleID.ValueInt64()))
+					if err != nil {
+						resp.Diagnostics.AddError("Failed to read role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d", source.RoleID.ValueInt64()), err))
+						return
+					}
+					data.Name = types.StringValue(role.Name)
+					data.Description = types.StringValue(role.Description)
+					data.Builtin = types.BoolValue(role.IsAdmin)
+				}
+
+				resp.Diagnostics.Append(data.setEffectiveRules(ctx)...)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+type roleRuleKey struct {
+	serverID       int64
+	permissionName string
+	stackPattern   string
+}
+
+func newRoleRuleKey(serverID int64, permissionName string, pattern types.String) roleRuleKey {
+	return roleRuleKey{serverID, permissionName, stackPatternValue(pattern)}
+}
+
+func (m *RoleResourceModel) exclusivePermissions() bool {
+	if m.ManageAllPermissions.IsNull() || m.ManageAllPermissions.IsUnknown() {
+		return len(m.Permissions) > 0 || len(m.PermissionSets) > 0
+	}
+	return m.ManageAllPermissions.ValueBool()
+}
+
+func sortRoleRuleKeys(keys []roleRuleKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].serverID != keys[j].serverID {
+			return keys[i].serverID < keys[j].serverID
+		}
+		if keys[i].permissionName != keys[j].permissionName {
+			return keys[i].permissionName < keys[j].permissionName
+		}
+		return keys[i].stackPattern < keys[j].stackPattern
+	})
+}
+
+func (m *RoleResourceModel) declaredRulesKnown() bool {
+	for _, permSet := range m.PermissionSets {
+		for _, serverID := range permSet.ServerIDs {
+			if serverID.IsUnknown() {
+				return false
+			}
+		}
+		for _, perm := range permSet.Permissions {
+			if perm.Name.IsUnknown() || perm.Pattern.IsUnknown() {
+				return false
+			}
+		}
+	}
+
+	for _, perm := range m.Permissions {
+		if perm.ServerID.IsUnknown() || perm.PermissionName.IsUnknown() || perm.StackPattern.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *RoleResourceModel) declaredRuleKeys() []roleRuleKey {
+	seen := make(map[roleRuleKey]bool)
+	keys := make([]roleRuleKey, 0)
+	add := func(key roleRuleKey) {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+
+	for _, permSet := range m.PermissionSets {
+		for _, serverID := range permSet.ServerIDs {
+			for _, perm := range permSet.Permissions {
+				add(newRoleRuleKey(serverID.ValueInt64(), perm.Name.ValueString(), perm.Pattern))
+			}
+		}
+	}
+
+	for _, perm := range m.Permissions {
+		add(newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern))
+	}
+
+	return keys
+}
+
+type inlinePermissionIDsModifier struct{}
+
+func (m inlinePermissionIDsModifier) Description(ctx context.Context) string {
+	return "Keeps the IDs of inline permissions that are unchanged in state."
+}
+
+func (m inlinePermissionIDsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m inlinePermissionIDsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planned, prior []RolePermissionInline
+	resp.Diagnostics.Append(req.PlanValue.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(req.StateValue.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keepInlinePermissionIDs(planned, prior)
+
+	value, diags := types.SetValueFrom(ctx, req.PlanValue.ElementType(ctx), planned)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = value
+}
+
+func keepInlinePermissionIDs(planned, prior []RolePermissionInline) {
+	ids := make(map[roleRuleKey]types.String, len(prior))
+	for _, perm := range prior {
+		ids[newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern)] = perm.ID
+	}
+
+	for i, perm := range planned {
+		if !perm.ID.IsUnknown() || perm.ServerID.IsUnknown() || perm.PermissionName.IsUnknown() || perm.StackPattern.IsUnknown() {
+			continue
+		}
+		if id, ok := ids[newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern)]; ok {
+			planned[i].ID = id
+		}
+	}
+}
+
+func (m *RoleResourceModel) refreshPermissions(perms []client.RolePermission, allPermissions []client.Permission) {
+	permissionNames := make(map[uint]string, len(allPermissions))
+	for _, p := range allPermissions {
+		permissionNames[p.ID] = p.Name
+	}
+
+	present := make(map[roleRuleKey]bool, len(perms))
+	for _, perm := range perms {
+		present[roleRuleKey{int64(perm.ServerID), permissionNames[perm.PermissionID], normalizeStackPattern(perm.StackPattern)}] = true
+	}
+
+	claimed := make(map[roleRuleKey]bool)
+	for i, permSet := range m.PermissionSets {
+		serverIDs := make([]types.Int64, 0, len(permSet.ServerIDs))
+		for _, serverID := range permSet.ServerIDs {
+			complete := true
+			for _, perm := range permSet.Permissions {
+				key := newRoleRuleKey(serverID.ValueInt64(), perm.Name.ValueString(), perm.Pattern)
+				if present[key] {
+					claimed[key] = true
+				} else {
+					complete = false
+				}
+			}
+			if complete {
+				serverIDs = append(serverIDs, serverID)
+			}
+		}
+		m.PermissionSets[i].ServerIDs = serverIDs
+	}
+
+	exclusive := m.exclusivePermissions()
+	if len(m.Permissions) == 0 && !exclusive {
+		return
+	}
+
+	declared := make(map[roleRuleKey]types.String, len(m.Permissions))
+	for _, perm := range m.Permissions {
+		declared[newRoleRuleKey(perm.ServerID.ValueInt64(), perm.PermissionName.ValueString(), perm.StackPattern)] = perm.ServerName
+	}
+
+	updatedPerms := make([]RolePermissionInline, 0, len(perms))
+	for _, perm := range perms {
+		key := roleRuleKey{int64(perm.ServerID), permissionNames[perm.PermissionID], normalizeStackPattern(perm.StackPattern)}
+		serverName, isDeclared := declared[key]
+		if !isDeclared && (claimed[key] || !exclusive) {
+			continue
+		}
+		if !isDeclared {
+			serverName = types.StringNull()
+		}
+		updatedPerms = append(updatedPerms, RolePermissionInline{
+			ID:             types.StringValue(strconv.FormatUint(uint64(perm.ID), 10)),
+			ServerID:       types.Int64Value(key.serverID),
+			ServerName:     serverName,
+			PermissionName: types.StringValue(key.permissionName),
+			StackPattern:   types.StringValue(key.stackPattern),
+		})
+	}
+	m.Permissions = updatedPerms
+}
+
+func (m *RoleResourceModel) setEffectiveRules(ctx context.Context) diag.Diagnostics {
+	keys := m.declaredRuleKeys()
+	sortRoleRuleKeys(keys)
+
+	rules := make([]EffectiveRule, 0, len(keys))
+	for _, key := range keys {
+		rules = append(rules, EffectiveRule{
+			ServerID:       types.Int64Value(key.serverID),
+			PermissionName: types.StringValue(key.permissionName),
+			StackPattern:   types.StringValue(key.stackPattern),
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: effectiveRuleAttrTypes}, rules)
+	m.EffectiveRules = list
+	return diags
+}
@@ -0,0 +1,795 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+func rolePlan(name, description string, permissions ...RolePermissionInline) RoleResourceModel {
+	for i := range permissions {
+		permissions[i].ID = types.StringUnknown()
+		if permissions[i].StackPattern.IsNull() {
+			permissions[i].StackPattern = types.StringUnknown()
+		}
+	}
+
+	return RoleResourceModel{
+		ID:                      types.StringUnknown(),
+		Name:                    types.StringValue(name),
+		Description:             types.StringValue(description),
+		ManageAllPermissions:    types.BoolNull(),
+		Builtin:                 types.BoolUnknown(),
+		AllowBuiltinRoleChanges: types.BoolValue(false),
+		Permissions:             permissions,
+		EffectiveRules:          types.ListUnknown(types.ObjectType{AttrTypes: effectiveRuleAttrTypes}),
+	}
+}
+
+func inlinePermission(serverID int64, name, pattern string) RolePermissionInline {
+	perm := RolePermissionInline{
+		ServerID:       types.Int64Value(serverID),
+		ServerName:     types.StringNull(),
+		PermissionName: types.StringValue(name),
+		StackPattern:   types.StringNull(),
+	}
+	if pattern != "" {
+		perm.StackPattern = types.StringValue(pattern)
+	}
+	return perm
+}
+
+func TestRoleResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.create(rolePlan("deployers", "Deploy access",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "stacks.manage", "prod-*"),
+	))
+
+	var created RoleResourceModel
+	h.get(state, &created)
+
+	roles := fake.Roles()
+	if len(roles) != 1 || roles[0].Name != "deployers" {
+		t.Fatalf("expected one role named deployers, got %+v", roles)
+	}
+	if created.ID.ValueString() != strconv.Itoa(int(roles[0].ID)) {
+		t.Fatalf("expected id %d, got %s", roles[0].ID, created.ID.ValueString())
+	}
+	if len(fake.Rules(roles[0].ID)) != 2 {
+		t.Fatalf("expected 2 rules, got %+v", fake.Rules(roles[0].ID))
+	}
+	if created.Permissions[0].StackPattern.ValueString() != "*" {
+		t.Fatalf("expected default stack pattern, got %s", created.Permissions[0].StackPattern)
+	}
+	for _, perm := range created.Permissions {
+		if perm.ID.IsUnknown() || perm.ID.ValueString() == "" {
+			t.Fatalf("expected permission ID to be set, got %+v", perm)
+		}
+	}
+	if len(created.EffectiveRules.Elements()) != 2 {
+		t.Fatalf("expected 2 effective rules, got %s", created.EffectiveRules)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	plan := rolePlan("deployers", "Deploy and read logs",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "logs.read", ""),
+	)
+	plan.ID = created.ID
+	state = h.update(state, plan)
+
+	var updated RoleResourceModel
+	h.get(state, &updated)
+	if updated.Description.ValueString() != "Deploy and read logs" || fake.Roles()[0].Description != "Deploy and read logs" {
+		t.Fatalf("description was not updated: %+v", fake.Roles())
+	}
+	rules := fake.Rules(roles[0].ID)
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules after update, got %+v", rules)
+	}
+
+	h.delete(state)
+	if len(fake.Roles()) != 0 {
+		t.Fatalf("expected role to be deleted, got %+v", fake.Roles())
+	}
+}
+
+func TestRoleResource_UpdateKeepsUnchangedRules(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.create(rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "stacks.manage", "prod-*"),
+	))
+
+	var created RoleResourceModel
+	h.get(state, &created)
+	role := fake.Roles()[0]
+
+	plan := rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "logs.read", ""),
+	)
+	plan.ID = created.ID
+	state = h.update(state, plan)
+
+	var updated RoleResourceModel
+	h.get(state, &updated)
+	if updated.Permissions[0].ID != created.Permissions[0].ID {
+		t.Fatalf("expected unchanged rule to keep ID %s, got %s", created.Permissions[0].ID, updated.Permissions[0].ID)
+	}
+
+	rules := fake.Rules(role.ID)
+	if len(rules) != 2 || strconv.Itoa(int(rules[1].ID)) != updated.Permissions[1].ID.ValueString() {
+		t.Fatalf("expected stacks.manage to be replaced by logs.read, got %+v", rules)
+	}
+}
+
+func TestRoleResource_ReorderedPermissionsKeepIDs(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+	ctx := context.Background()
+
+	state := h.create(rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "stacks.manage", "prod-*"),
+	))
+
+	plan := h.plan(rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.manage", "prod-*"),
+		inlinePermission(int64(server.ID), "stacks.read", "*"),
+	))
+
+	var stateValue, planValue types.Set
+	requireNoDiags(t, state.GetAttribute(ctx, path.Root("permissions"), &stateValue))
+	requireNoDiags(t, plan.GetAttribute(ctx, path.Root("permissions"), &planValue))
+
+	var resp planmodifier.SetResponse
+	inlinePermissionIDsModifier{}.PlanModifySet(ctx, planmodifier.SetRequest{
+		Path:       path.Root("permissions"),
+		StateValue: stateValue,
+		PlanValue:  planValue,
+	}, &resp)
+	requireNoDiags(t, resp.Diagnostics)
+
+	if !resp.PlanValue.Equal(stateValue) {
+		t.Fatalf("expected reordered permissions to plan no changes, got %s", resp.PlanValue)
+	}
+}
+
+func TestRoleResource_Drift(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.create(rolePlan("readers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID), "logs.read", ""),
+	))
+
+	var created RoleResourceModel
+	h.get(state, &created)
+	logsRuleID, err := strconv.Atoi(created.Permissions[1].ID.ValueString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake.RemoveRule(fake.Roles()[0].ID, int32(logsRuleID))
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var refreshed RoleResourceModel
+	h.get(state, &refreshed)
+	if len(refreshed.Permissions) != 1 || refreshed.Permissions[0].PermissionName.ValueString() != "stacks.read" {
+		t.Fatalf("expected only stacks.read after drift, got %+v", refreshed.Permissions)
+	}
+}
+
+func TestRoleResource_PermissionSetDrift(t *testing.T) {
+	fake, c := newTestClient(t)
+	prod := fake.AddServer("prod", "10.0.0.1", 8081)
+	staging := fake.AddServer("staging", "10.0.0.2", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	plan := rolePlan("readers", "", inlinePermission(int64(prod.ID), "files.read", ""))
+	plan.PermissionSets = []PermissionSet{{
+		ServerIDs: []types.Int64{types.Int64Value(int64(prod.ID)), types.Int64Value(int64(staging.ID))},
+		Permissions: []PermissionDefinition{
+			{Name: types.StringValue("stacks.read"), Pattern: types.StringNull()},
+			{Name: types.StringValue("logs.read"), Pattern: types.StringNull()},
+		},
+	}}
+	state := h.create(plan)
+
+	logsRead, err := c.GetPermissionByName(context.Background(), "logs.read")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	role := fake.Roles()[0]
+	for _, rule := range fake.Rules(role.ID) {
+		if rule.ServerID == staging.ID && uint(rule.PermissionID) == logsRead.ID {
+			fake.RemoveRule(role.ID, rule.ID)
+		}
+	}
+	fake.AddRule(role.ID, prod.ID, "files.write", "*")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var refreshed RoleResourceModel
+	h.get(state, &refreshed)
+
+	serverIDs := refreshed.PermissionSets[0].ServerIDs
+	if len(serverIDs) != 1 || serverIDs[0].ValueInt64() != int64(prod.ID) {
+		t.Fatalf("expected staging to drop out of the permission set, got %v", serverIDs)
+	}
+
+	var inline []string
+	for _, perm := range refreshed.Permissions {
+		inline = append(inline, perm.PermissionName.ValueString())
+	}
+	if len(inline) != 2 || inline[0] != "files.read" || inline[1] != "files.write" {
+		t.Fatalf("expected inline permissions to show only files.read and the out-of-band files.write, got %v", inline)
+	}
+}
+
+func TestRoleResource_ManageAllPermissions(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	plan := rolePlan("readers", "", inlinePermission(int64(server.ID), "stacks.read", ""))
+	plan.ManageAllPermissions = types.BoolValue(false)
+	state := h.create(plan)
+
+	var created RoleResourceModel
+	h.get(state, &created)
+
+	role := fake.Roles()[0]
+	fake.AddRule(role.ID, server.ID, "files.write", "*")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var refreshed RoleResourceModel
+	h.get(state, &refreshed)
+	if len(refreshed.Permissions) != 1 {
+		t.Fatalf("expected out-of-band rule to be ignored, got %+v", refreshed.Permissions)
+	}
+
+	plan = rolePlan("readers", "Read only", inlinePermission(int64(server.ID), "stacks.read", ""))
+	plan.ID = created.ID
+	plan.ManageAllPermissions = types.BoolValue(false)
+	state = h.update(state, plan)
+	if len(fake.Rules(role.ID)) != 2 {
+		t.Fatalf("expected out-of-band rule to be kept, got %+v", fake.Rules(role.ID))
+	}
+
+	plan.ManageAllPermissions = types.BoolNull()
+	state = h.update(state, plan)
+	if rules := fake.Rules(role.ID); len(rules) != 1 || strconv.Itoa(int(rules[0].ID)) != created.Permissions[0].ID.ValueString() {
+		t.Fatalf("expected only the declared rule to remain, got %+v", rules)
+	}
+
+	plan.Permissions = nil
+	plan.ManageAllPermissions = types.BoolValue(true)
+	state = h.update(state, plan)
+	if rules := fake.Rules(role.ID); len(rules) != 0 {
+		t.Fatalf("expected every rule to be deleted, got %+v", rules)
+	}
+
+	fake.AddRule(role.ID, server.ID, "logs.read", "prod-*")
+
+	state, diags = h.read(state)
+	requireNoDiags(t, diags)
+
+	h.get(state, &refreshed)
+	if len(refreshed.Permissions) != 1 || refreshed.Permissions[0].PermissionName.ValueString() != "logs.read" || refreshed.Permissions[0].StackPattern.ValueString() != "prod-*" {
+		t.Fatalf("expected out-of-band rule to show up as drift, got %+v", refreshed.Permissions)
+	}
+}
+
+func TestRoleResource_LeavesRulesAloneWithoutBlocks(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.create(rolePlan("readers", ""))
+	role := fake.Roles()[0]
+	fake.AddRule(role.ID, server.ID, "stacks.read", "*")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var refreshed RoleResourceModel
+	h.get(state, &refreshed)
+	if len(refreshed.Permissions) != 0 {
+		t.Fatalf("expected rules to stay out of state, got %+v", refreshed.Permissions)
+	}
+
+	plan := rolePlan("readers", "changed")
+	plan.ID = refreshed.ID
+	h.update(state, plan)
+	if len(fake.Rules(role.ID)) != 1 {
+		t.Fatalf("expected rule managed elsewhere to be kept, got %+v", fake.Rules(role.ID))
+	}
+}
+
+func TestRoleResource_CreatesRulesConcurrently(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	permissions := make([]RolePermissionInline, 0, 30)
+	for i := range 30 {
+		permissions = append(permissions, inlinePermission(int64(server.ID), "stacks.read", fmt.Sprintf("stack-%d", i)))
+	}
+	state := h.create(rolePlan("readers", "", permissions...))
+
+	var created RoleResourceModel
+	h.get(state, &created)
+
+	rules := make(map[string]string)
+	for _, rule := range fake.Rules(fake.Roles()[0].ID) {
+		rules[strconv.Itoa(int(rule.ID))] = rule.StackPattern
+	}
+	if len(rules) != 30 {
+		t.Fatalf("expected 30 rules, got %d", len(rules))
+	}
+	for i, perm := range created.Permissions {
+		if want := fmt.Sprintf("stack-%d", i); rules[perm.ID.ValueString()] != want {
+			t.Fatalf("expected permission %d to hold the ID of the %s rule, got %s", i, want, perm.ID)
+		}
+	}
+}
+
+func TestRoleResource_CreateFailureSavesPartialState(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state, diags := h.tryCreate(rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID)+100, "stacks.manage", ""),
+	))
+
+	if !diags.HasError() {
+		t.Fatal("expected rule creation to fail")
+	}
+	if state.Raw.IsNull() {
+		t.Fatal("expected partially created role to be saved to state")
+	}
+
+	var partial RoleResourceModel
+	h.get(state, &partial)
+
+	roles := fake.Roles()
+	if len(roles) != 1 || partial.ID.ValueString() != strconv.Itoa(int(roles[0].ID)) {
+		t.Fatalf("expected state to hold role %+v, got %s", roles, partial.ID)
+	}
+	rules := fake.Rules(roles[0].ID)
+	if len(rules) != 1 || len(partial.Permissions) != 1 {
+		t.Fatalf("expected only the created rule in state, got %+v (server %+v)", partial.Permissions, rules)
+	}
+	if partial.Permissions[0].ID.ValueString() != strconv.Itoa(int(rules[0].ID)) {
+		t.Fatalf("expected rule ID %d, got %s", rules[0].ID, partial.Permissions[0].ID)
+	}
+
+	other := fake.AddServer("staging", "10.0.0.2", 8081)
+	state, diags = h.read(state)
+	requireNoDiags(t, diags)
+	plan := rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(other.ID), "stacks.manage", ""),
+	)
+	plan.ID = partial.ID
+	h.update(state, plan)
+	if roles := fake.Roles(); len(roles) != 1 || len(fake.Rules(roles[0].ID)) != 2 {
+		t.Fatalf("expected the untainted role to be completed in place, got roles %+v", roles)
+	}
+}
+
+func TestRoleResource_DeletedOutOfBand(t *testing.T) {
+	fake, c := newTestClient(t)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.create(rolePlan("readers", ""))
+	fake.RemoveRole(fake.Roles()[0].ID)
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected deleted role to be removed from state")
+	}
+}
+
+func TestRoleResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	role := fake.AddRole("operators", "Imported role")
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.importState(strconv.Itoa(int(role.ID)))
+
+	var imported RoleResourceModel
+	h.get(state, &imported)
+	if imported.Name.ValueString() != "operators" || imported.Description.ValueString() != "Imported role" {
+		t.Fatalf("unexpected imported role: %+v", imported)
+	}
+}
+
+func TestRoleResource_BuiltinRole(t *testing.T) {
+	fake, c := newTestClient(t)
+	role := fake.AddAdminRole("admin")
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	state := h.importState(strconv.Itoa(int(role.ID)))
+
+	var imported RoleResourceModel
+	h.get(state, &imported)
+	if !imported.Builtin.ValueBool() {
+		t.Fatal("expected imported admin role to be marked builtin")
+	}
+
+	if got := errorSummaries(h.modifyPlanFrom(state, nil)); len(got) != 1 || got[0] != "Cannot delete built-in role" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+
+	plan := imported
+	plan.Name = types.StringValue("administrators")
+	if got := errorSummaries(h.modifyPlanFrom(state, plan)); len(got) != 1 || got[0] != "Cannot rename built-in role" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+
+	plan.AllowBuiltinRoleChanges = types.BoolValue(true)
+	requireNoDiags(t, h.modifyPlanFrom(state, plan))
+
+	resp := resource.DeleteResponse{State: state}
+	h.resource.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+	if got := errorSummaries(resp.Diagnostics); len(got) != 1 || got[0] != "Cannot delete built-in role" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+	if len(fake.Roles()) != 1 {
+		t.Fatal("expected built-in role to survive")
+	}
+
+	state = h.update(state, plan)
+	h.delete(state)
+	if len(fake.Roles()) != 0 {
+		t.Fatal("expected built-in role to be deleted once allowed")
+	}
+}
+
+func TestRoleResource_UnsupportedPermission(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.RemovePermission("logs.read")
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	plan := rolePlan("deployers", "", inlinePermission(int64(server.ID), "stacks.read", ""))
+	plan.PermissionSets = []PermissionSet{{
+		ServerIDs:   []types.Int64{types.Int64Value(int64(server.ID))},
+		Permissions: []PermissionDefinition{{Name: types.StringValue("logs.read"), Pattern: types.StringNull()}},
+	}}
+
+	diags := h.modifyPlan(plan)
+	if got := errorSummaries(diags); len(got) != 1 || got[0] != "Permission not supported by Berth server" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+	got := diags.Errors()[0].(diag.DiagnosticWithPath).Path()
+	if len(got.Steps()) != 5 || !got.ParentPath().ParentPath().ParentPath().ParentPath().Equal(path.Root("permission_set")) || !strings.HasSuffix(got.String(), ".name") {
+		t.Fatalf("expected diagnostic on a permission_set permission name, got %s", got)
+	}
+}
+
+func TestRoleResource_ValidateReferences(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+
+	plan := rolePlan("deployers", "",
+		inlinePermission(int64(server.ID), "stacks.read", ""),
+		inlinePermission(int64(server.ID)+100, "logs.read", ""),
+	)
+	plan.PermissionSets = []PermissionSet{{
+		ServerIDs:   []types.Int64{types.Int64Value(int64(server.ID)), types.Int64Value(int64(server.ID) + 200)},
+		Permissions: []PermissionDefinition{{Name: types.StringValue("stacks.read"), Pattern: types.StringNull()}},
+	}}
+
+	lenient := newResourceHarness(t, NewRoleResource(), c)
+	requireNoDiags(t, lenient.modifyPlan(plan))
+
+	strict := newResourceHarnessWithData(t, NewRoleResource(), &ProviderData{Client: c, RuleConcurrency: 4, ValidateReferences: true})
+	diags := strict.modifyPlan(plan)
+	if got := errorSummaries(diags); len(got) != 2 || got[0] != "Server not found" || got[1] != "Server not found" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+	for _, d := range diags.Errors() {
+		if !strings.Contains(d.Detail(), "prod (") {
+			t.Fatalf("expected available servers in detail, got %q", d.Detail())
+		}
+	}
+}
+
+func TestRoleResource_ServerName(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	byName := inlinePermission(0, "stacks.read", "*")
+	byName.ServerID = types.Int64Null()
+	byName.ServerName = types.StringValue("prod")
+	requireNoDiags(t, h.validateConfig(rolePlan("deployers", "", byName)))
+
+	byName.ServerID = types.Int64Unknown()
+	state := h.create(rolePlan("deployers", "", byName))
+
+	var created RoleResourceModel
+	h.get(state, &created)
+	if len(created.Permissions) != 1 || created.Permissions[0].ServerID.ValueInt64() != int64(server.ID) || created.Permissions[0].ServerName.ValueString() != "prod" {
+		t.Fatalf("expected server %d stored alongside its name, got %+v", server.ID, created.Permissions)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	h.get(state, &created)
+	if len(created.Permissions) != 1 || created.Permissions[0].ServerName.ValueString() != "prod" {
+		t.Fatalf("expected server_name to survive refresh, got %+v", created.Permissions)
+	}
+
+	plan := rolePlan("deployers", "", byName)
+	plan.ID = created.ID
+	planned, diags := h.modifiedPlanFrom(state, plan)
+	requireNoDiags(t, diags)
+	var modified RoleResourceModel
+	requireNoDiags(t, planned.Get(context.Background(), &modified))
+	if modified.Permissions[0].ServerID.ValueInt64() != int64(server.ID) || !modified.Permissions[0].ID.Equal(created.Permissions[0].ID) {
+		t.Fatalf("expected plan to resolve the server and keep the rule ID, got %+v", modified.Permissions)
+	}
+
+	conflicting := inlinePermission(int64(server.ID), "stacks.read", "")
+	conflicting.ServerName = types.StringValue("prod")
+	if got := errorSummaries(h.validateConfig(rolePlan("deployers", "", conflicting))); len(got) != 1 || got[0] != "Conflicting server reference" {
+		t.Fatalf("unexpected diagnostics: %v", got)
+	}
+}
+
+func mockRoleState() RoleResourceModel {
+	state := rolePlan("deployers", "", inlinePermission(1, "stacks.read", "*"))
+	state.ID = types.StringValue("7")
+	state.Builtin = types.BoolValue(false)
+	state.Permissions[0].ID = types.StringValue("11")
+	state.EffectiveRules = types.ListNull(types.ObjectType{AttrTypes: effectiveRuleAttrTypes})
+	return state
+}
+
+func TestRoleResource_MockedAPI(t *testing.T) {
+	created := func(name, description string) (*client.Role, error) {
+		return &client.Role{ID: 7, Name: name, Description: description}, nil
+	}
+	existing := func(id uint) (*client.Role, error) {
+		return &client.Role{ID: id, Name: "deployers"}, nil
+	}
+	createdRule := func(roleID, serverID, permissionID uint, stackPattern string) (*client.RolePermission, error) {
+		return &client.RolePermission{ID: 11, ServerID: serverID, PermissionID: permissionID, StackPattern: stackPattern}, nil
+	}
+
+	createPlan := rolePlan("deployers", "", inlinePermission(1, "stacks.read", ""))
+	updatePlan := mockRoleState()
+	updatePlan.Description = types.StringValue("changed")
+
+	tests := []struct {
+		name        string
+		api         mockBerthAPI
+		run         func(h *resourceHarness) (tfsdk.State, diag.Diagnostics)
+		wantError   string
+		wantWarning string
+		wantState   bool
+	}{
+		{
+			name: "create",
+			api:  mockBerthAPI{createRole: created, createRolePermission: createdRule},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(createPlan)
+			},
+			wantState: true,
+		},
+		{
+			name: "create role fails",
+			api: mockBerthAPI{createRole: func(string, string) (*client.Role, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(createPlan)
+			},
+			wantError: "Failed to create role",
+		},
+		{
+			name: "create with unknown permission",
+			api:  mockBerthAPI{createRole: created},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(rolePlan("deployers", "", inlinePermission(1, "stacks.raed", "")))
+			},
+			wantError:   "Failed to find permission",
+			wantWarning: "Role partially created",
+			wantState:   true,
+		},
+		{
+			name: "create rule fails",
+			api: mockBerthAPI{createRole: created, createRolePermission: func(uint, uint, uint, string) (*client.RolePermission, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryCreate(createPlan)
+			},
+			wantError:   "Failed to create role permission",
+			wantWarning: "Role partially created",
+			wantState:   true,
+		},
+		{
+			name: "read",
+			api: mockBerthAPI{getRole: existing, listRolePermissions: func(uint) ([]client.RolePermission, []client.Permission, error) {
+				return []client.RolePermission{{ID: 11, ServerID: 1, PermissionID: 1, StackPattern: "*"}}, mockPermissions, nil
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(mockRoleState()))
+			},
+			wantState: true,
+		},
+		{
+			name: "read deleted role",
+			api: mockBerthAPI{getRole: func(uint) (*client.Role, error) {
+				return nil, fmt.Errorf("role %w", client.ErrNotFound)
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(mockRoleState()))
+			},
+		},
+		{
+			name: "read role fails",
+			api: mockBerthAPI{getRole: func(uint) (*client.Role, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(mockRoleState()))
+			},
+			wantError: "Failed to read role",
+			wantState: true,
+		},
+		{
+			name: "read rules fail",
+			api: mockBerthAPI{getRole: existing, listRolePermissions: func(uint) ([]client.RolePermission, []client.Permission, error) {
+				return nil, nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.read(h.stateFrom(mockRoleState()))
+			},
+			wantError: "Failed to read role permissions",
+			wantState: true,
+		},
+		{
+			name: "update",
+			api: mockBerthAPI{
+				updateRole: func(id uint, name, description string) (*client.Role, error) {
+					return &client.Role{ID: id, Name: name, Description: description}, nil
+				},
+				listRolePermissions: func(uint) ([]client.RolePermission, []client.Permission, error) {
+					return []client.RolePermission{{ID: 11, ServerID: 1, PermissionID: 1, StackPattern: "*"}}, mockPermissions, nil
+				},
+			},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryUpdate(h.stateFrom(mockRoleState()), updatePlan)
+			},
+			wantState: true,
+		},
+		{
+			name: "update role fails",
+			api: mockBerthAPI{updateRole: func(uint, string, string) (*client.Role, error) {
+				return nil, mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.tryUpdate(h.stateFrom(mockRoleState()), updatePlan)
+			},
+			wantError: "Failed to update role",
+			wantState: true,
+		},
+		{
+			name: "delete already deleted role",
+			api: mockBerthAPI{deleteRole: func(uint) error {
+				return fmt.Errorf("role %w", client.ErrNotFound)
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.emptyState(), h.tryDelete(h.stateFrom(mockRoleState()))
+			},
+		},
+		{
+			name: "delete role fails",
+			api: mockBerthAPI{deleteRole: func(uint) error {
+				return mockServerError()
+			}},
+			run: func(h *resourceHarness) (tfsdk.State, diag.Diagnostics) {
+				return h.emptyState(), h.tryDelete(h.stateFrom(mockRoleState()))
+			},
+			wantError: "Failed to delete role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newResourceHarness(t, NewRoleResource(), &tt.api)
+			state, diags := tt.run(h)
+			requireDiagnostics(t, diags, tt.wantError, tt.wantWarning)
+			if state.Raw.IsNull() == tt.wantState {
+				t.Fatalf("expected state present = %v, got %v", tt.wantState, !state.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestRoleResource_PlansEffectiveRules(t *testing.T) {
+	fake, c := newTestClient(t)
+	prod := fake.AddServer("prod", "10.0.0.1", 8081)
+	staging := fake.AddServer("staging", "10.0.0.2", 8081)
+	h := newResourceHarness(t, NewRoleResource(), c)
+
+	byName := inlinePermission(0, "logs.read", "*")
+	byName.ServerID = types.Int64Unknown()
+	byName.ServerName = types.StringValue("staging")
+
+	plan := rolePlan("deployers", "", inlinePermission(int64(prod.ID), "stacks.read", "*"), byName)
+	plan.PermissionSets = []PermissionSet{{
+		ServerIDs:   []types.Int64{types.Int64Value(int64(prod.ID)), types.Int64Value(int64(staging.ID))},
+		Permissions: []PermissionDefinition{{Name: types.StringValue("stacks.read"), Pattern: types.StringValue("*")}},
+	}}
+
+	planned, diags := h.modifiedPlanFrom(h.emptyState(), plan)
+	requireNoDiags(t, diags)
+
+	var modified RoleResourceModel
+	requireNoDiags(t, planned.Get(context.Background(), &modified))
+
+	var rules []EffectiveRule
+	requireNoDiags(t, modified.EffectiveRules.ElementsAs(context.Background(), &rules, false))
+	want := []struct {
+		serverID uint
+		name     string
+	}{
+		{uint(prod.ID), "stacks.read"},
+		{uint(staging.ID), "logs.read"},
+		{uint(staging.ID), "stacks.read"},
+	}
+	if len(rules) != len(want) {
+		t.Fatalf("expected %d effective rules, got %+v", len(want), rules)
+	}
+	for i, w := range want {
+		if rules[i].ServerID.ValueInt64() != int64(w.serverID) || rules[i].PermissionName.ValueString() != w.name || rules[i].StackPattern.ValueString() != "*" {
+			t.Fatalf("unexpected effective rule %d: %+v", i, rules[i])
+		}
+	}
+
+	plan.Permissions[0].StackPattern = types.StringUnknown()
+	planned, diags = h.modifiedPlanFrom(h.emptyState(), plan)
+	requireNoDiags(t, diags)
+	requireNoDiags(t, planned.Get(context.Background(), &modified))
+	if !modified.EffectiveRules.IsUnknown() {
+		t.Fatalf("expected effective_rules to stay unknown while a rule is unknown, got %s", modified.EffectiveRules)
+	}
+}
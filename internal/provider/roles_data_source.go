@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &RolesDataSource{}
+
+func NewRolesDataSource() datasource.DataSource {
+	return &RolesDataSource{}
+}
+
+type RolesDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type RolesDataSourceModel struct {
+	ID                types.String       `tfsdk:"id"`
+	NamePrefix        types.String       `tfsdk:"name_prefix"`
+	ExpandPermissions types.Bool         `tfsdk:"expand_permissions"`
+	IDs               []types.Int64      `tfsdk:"ids"`
+	Roles             []RoleSummaryModel `tfsdk:"roles"`
+}
+
+type RoleSummaryModel struct {
+	ID          types.Int64            `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	IsAdmin     types.Bool             `tfsdk:"is_admin"`
+	Permissions []RolePermissionInline `tfsdk:"permissions"`
+}
+
+func (d *RolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_roles"
+}
+
+func (d *RolesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every role defined in Berth, including roles not managed by Terraform",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"name_prefix": schema.StringAttribute{
+				Description: "Only return roles whose name starts with this prefix",
+				Optional:    true,
+			},
+			"expand_permissions": schema.BoolAttribute{
+				Description: "Whether to read each matching role's permission rules into roles[*].permissions. Defaults to false",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching roles",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"roles": schema.ListNestedAttribute{
+				Description: "Matching roles",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Role ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Role name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Role description",
+							Computed:    true,
+						},
+						"is_admin": schema.BoolAttribute{
+							Description: "Whether the role is an admin role",
+							Computed:    true,
+						},
+						"permissions": rolePermissionsAttribute("Permission rules assigned to the role. Null unless expand_permissions is true"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *RolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RolesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list roles", apiErrorDetail(d.providerData, "Roles", err))
+		return
+	}
+
+	prefix := data.NamePrefix.ValueString()
+
+	var serverNames map[uint]string
+	if data.ExpandPermissions.ValueBool() {
+		serverNames, err = serverNamesByID(ctx, d.client)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+			return
+		}
+	}
+
+	data.IDs = make([]types.Int64, 0, len(roles))
+	data.Roles = make([]RoleSummaryModel, 0, len(roles))
+	for _, role := range roles {
+		if !strings.HasPrefix(role.Name, prefix) {
+			continue
+		}
+
+		summary := RoleSummaryModel{
+			ID:          types.Int64Value(int64(role.ID)),
+			Name:        types.StringValue(role.Name),
+			Description: types.StringValue(role.Description),
+			IsAdmin:     types.BoolValue(role.IsAdmin),
+		}
+		if serverNames != nil {
+			summary.Permissions, err = readRolePermissions(ctx, d.client, role.ID, serverNames)
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to read role permissions", apiErrorDetail(d.providerData, fmt.Sprintf("Role %q", role.Name), err))
+				return
+			}
+		}
+
+		data.IDs = append(data.IDs, types.Int64Value(int64(role.ID)))
+		data.Roles = append(data.Roles, summary)
+	}
+
+	data.ID = types.StringValue("roles")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+func TestRolesDataSource_NamePrefix(t *testing.T) {
+	fake, c := newTestClient(t)
+	fake.AddRole("team-a-deploy", "")
+	fake.AddRole("viewers", "")
+	fake.AddRole("team-a-read", "")
+
+	state, diags := readDataSource(t, NewRolesDataSource(), c, RolesDataSourceModel{
+		ID:                types.StringNull(),
+		NamePrefix:        types.StringValue("team-a-"),
+		ExpandPermissions: types.BoolNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data RolesDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if len(data.Roles) != 2 || data.Roles[0].Name.ValueString() != "team-a-deploy" || data.Roles[1].Name.ValueString() != "team-a-read" {
+		t.Fatalf("unexpected roles: %+v", data.Roles)
+	}
+	if len(data.IDs) != 2 || data.IDs[0] != data.Roles[0].ID {
+		t.Fatalf("unexpected ids: %v", data.IDs)
+	}
+	if data.Roles[0].Permissions != nil {
+		t.Fatalf("expected permissions to be null without expand_permissions, got %+v", data.Roles[0].Permissions)
+	}
+}
+
+func TestRolesDataSource_ExpandPermissions(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	deploy := fake.AddRole("deploy", "")
+	fake.AddRule(deploy.ID, server.ID, "stacks.manage", "app-*")
+	fake.AddRule(deploy.ID, server.ID, "logs.read", "*")
+	fake.AddRole("empty", "")
+
+	state, diags := readDataSource(t, NewRolesDataSource(), c, RolesDataSourceModel{
+		ID:                types.StringNull(),
+		NamePrefix:        types.StringNull(),
+		ExpandPermissions: types.BoolValue(true),
+	})
+	requireNoDiags(t, diags)
+
+	var data RolesDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if len(data.Roles) != 2 {
+		t.Fatalf("unexpected roles: %+v", data.Roles)
+	}
+
+	got := map[string][]RolePermissionInline{}
+	for _, role := range data.Roles {
+		got[role.Name.ValueString()] = role.Permissions
+	}
+	perms := got["deploy"]
+	if len(perms) != 2 || perms[0].PermissionName.ValueString() != "stacks.manage" || perms[0].ServerName.ValueString() != "prod" || perms[1].StackPattern.ValueString() != "*" {
+		t.Fatalf("unexpected deploy permissions: %+v", perms)
+	}
+	if empty, ok := got["empty"]; !ok || empty == nil || len(empty) != 0 {
+		t.Fatalf("expected an empty permission list for the empty role, got %+v", empty)
+	}
+}
+
+func TestRolesDataSource_ListError(t *testing.T) {
+	api := &mockBerthAPI{listRoles: func() ([]client.Role, error) {
+		return nil, mockServerError()
+	}}
+
+	_, diags := readDataSource(t, NewRolesDataSource(), api, RolesDataSourceModel{
+		ID:                types.StringNull(),
+		NamePrefix:        types.StringNull(),
+		ExpandPermissions: types.BoolNull(),
+	})
+	requireDiagnostics(t, diags, "Failed to list roles", "")
+}
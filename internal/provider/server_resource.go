@@ -0,0 +1,323 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ resource.Resource = &ServerResource{}
+var _ resource.ResourceWithImportState = &ServerResource{}
+var _ resource.ResourceWithModifyPlan = &ServerResource{}
+var _ resource.ResourceWithValidateConfig = &ServerResource{}
+
+var serverOnlinePollInterval = 5 * time.Second
+
+func NewServerResource() resource.Resource {
+	return &ServerResource{}
+}
+
+type ServerResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type ServerResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Description          types.String `tfsdk:"description"`
+	Host                 types.String `tfsdk:"host"`
+	Port                 types.Int64  `tfsdk:"port"`
+	AccessToken          types.String `tfsdk:"access_token"`
+	SkipSSLVerification  types.Bool   `tfsdk:"skip_ssl_verification"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	WaitForOnline        types.Bool   `tfsdk:"wait_for_online"`
+	WaitForOnlineTimeout types.String `tfsdk:"wait_for_online_timeout"`
+}
+
+func (r *ServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server"
+}
+
+func (r *ServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a server running the Berth agent",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Server ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Server name",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Server description",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"host": schema.StringAttribute{
+				Description: "Hostname or IP address of the Berth agent",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of the Berth agent. Defaults to 8081",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(8081),
+			},
+			"access_token": schema.StringAttribute{
+				Description: "Access token used to authenticate with the Berth agent. Not returned by the API, so changes made outside Terraform are not detected",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"skip_ssl_verification": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification when connecting to the agent. Defaults to false",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Whether the server is active. Defaults to true",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"wait_for_online": schema.BoolAttribute{
+				Description: "Wait after create and update until Berth can connect to the agent, so dependent resources do not run against an agent that is not up yet. Defaults to false",
+				Optional:    true,
+			},
+			"wait_for_online_timeout": schema.StringAttribute{
+				Description: "How long to wait for the agent when wait_for_online is set, as a duration such as '5m'. Defaults to '5m'",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *ServerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var timeout types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("wait_for_online_timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() || timeout.IsUnknown() {
+		return
+	}
+
+	_, diags := parseDurationAttribute(timeout, path.Root("wait_for_online_timeout"), 0)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *ServerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFeatureSupported(ctx, r.providerData, "berth_server", client.FeatureServerManagement)...)
+}
+
+func (r *ServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.client.CreateServer(ctx, data.toServer(), data.AccessToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %q", data.Name.ValueString()), err))
+		return
+	}
+
+	data.setServer(server)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForOnline(ctx, server, data)...)
+}
+
+func (r *ServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	server, err := r.client.GetServer(ctx, uint(id))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %d", id), err))
+		return
+	}
+
+	data.setServer(server)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	server, err := r.client.UpdateServer(ctx, uint(id), data.toServer(), data.AccessToken.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to update server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %d", id), err))
+		return
+	}
+
+	data.setServer(server)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForOnline(ctx, server, data)...)
+}
+
+func (r *ServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	if err := r.client.DeleteServer(ctx, uint(id)); err != nil {
+		resp.Diagnostics.AddError("Failed to delete server", apiErrorDetail(r.providerData, fmt.Sprintf("Server %d", id), err))
+		return
+	}
+}
+
+func (r *ServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ServerResource) waitForOnline(ctx context.Context, server *client.Server, data ServerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.WaitForOnline.ValueBool() {
+		return diags
+	}
+
+	timeout, diags := parseDurationAttribute(data.WaitForOnlineTimeout, path.Root("wait_for_online_timeout"), 5*time.Minute)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		err := r.client.TestServerConnection(ctx, server.ID)
+		if err == nil {
+			return diags
+		}
+
+		var apiErr *client.APIError
+		if ctx.Err() == nil && (!errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable) {
+			diags.AddError("Failed to test server connection", apiErrorDetail(r.providerData, fmt.Sprintf("Server %d", server.ID), err))
+			return diags
+		}
+
+		tflog.Debug(ctx, "Waiting for Berth agent to come online", map[string]any{
+			"server_id": server.ID,
+			"error":     err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			diags.AddAttributeError(
+				path.Root("wait_for_online"),
+				"Server did not come online",
+				fmt.Sprintf("Berth could not connect to the agent of server '%s' at %s:%d within %s. Check that the agent is running and reachable. Last error: %s", server.Name, server.Host, server.Port, timeout, err),
+			)
+			return diags
+		case <-time.After(serverOnlinePollInterval):
+		}
+	}
+}
+
+func (m *ServerResourceModel) toServer() client.Server {
+	return client.Server{
+		Name:                m.Name.ValueString(),
+		Description:         m.Description.ValueString(),
+		Host:                m.Host.ValueString(),
+		Port:                uint(m.Port.ValueInt64()),
+		IsActive:            m.IsActive.ValueBool(),
+		SkipSSLVerification: m.SkipSSLVerification.ValueBool(),
+	}
+}
+
+func (m *ServerResourceModel) setServer(server *client.Server) {
+	m.ID = types.StringValue(strconv.FormatUint(uint64(server.ID), 10))
+	m.Name = types.StringValue(server.Name)
+	m.Description = types.StringValue(server.Description)
+	m.Host = types.StringValue(server.Host)
+	m.Port = types.Int64Value(int64(server.Port))
+	m.IsActive = types.BoolValue(server.IsActive)
+	m.SkipSSLVerification = types.BoolValue(server.SkipSSLVerification)
+}
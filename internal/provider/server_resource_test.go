@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServerResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	h := newResourceHarness(t, NewServerResource(), c)
+
+	plan := ServerResourceModel{
+		ID:                  types.StringUnknown(),
+		Name:                types.StringValue("prod-1"),
+		Description:         types.StringValue(""),
+		Host:                types.StringValue("10.0.0.1"),
+		Port:                types.Int64Value(8081),
+		AccessToken:         types.StringValue("agent-token"),
+		SkipSSLVerification: types.BoolValue(false),
+		IsActive:            types.BoolValue(true),
+	}
+	state := h.create(plan)
+
+	servers := fake.Servers()
+	if len(servers) != 1 || servers[0].Host != "10.0.0.1" {
+		t.Fatalf("unexpected servers: %+v", servers)
+	}
+
+	var created ServerResourceModel
+	h.get(state, &created)
+	if created.AccessToken.ValueString() != "agent-token" {
+		t.Fatal("expected access token to be kept in state")
+	}
+
+	plan.ID = created.ID
+	plan.Host = types.StringValue("10.0.0.2")
+	plan.SkipSSLVerification = types.BoolValue(true)
+	state = h.update(state, plan)
+
+	if s := fake.Servers()[0]; s.Host != "10.0.0.2" || !s.SkipSSLVerification {
+		t.Fatalf("server was not updated: %+v", s)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	h.delete(state)
+	if len(fake.Servers()) != 0 {
+		t.Fatalf("expected server to be deleted, got %+v", fake.Servers())
+	}
+}
+
+func waitingServerPlan(timeout string) ServerResourceModel {
+	return ServerResourceModel{
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("prod-1"),
+		Description:          types.StringValue(""),
+		Host:                 types.StringValue("10.0.0.1"),
+		Port:                 types.Int64Value(8081),
+		AccessToken:          types.StringValue("agent-token"),
+		SkipSSLVerification:  types.BoolValue(false),
+		IsActive:             types.BoolValue(true),
+		WaitForOnline:        types.BoolValue(true),
+		WaitForOnlineTimeout: types.StringValue(timeout),
+	}
+}
+
+func TestServerResource_WaitForOnline(t *testing.T) {
+	interval := serverOnlinePollInterval
+	serverOnlinePollInterval = time.Millisecond
+	t.Cleanup(func() { serverOnlinePollInterval = interval })
+
+	t.Run("comes online", func(t *testing.T) {
+		fake, c := newTestClient(t)
+		h := newResourceHarness(t, NewServerResource(), c)
+
+		fake.SetAgentOfflineFor("10.0.0.1", 2)
+		state := h.create(waitingServerPlan("5s"))
+
+		var created ServerResourceModel
+		h.get(state, &created)
+		if got := fake.RequestCount(http.MethodPost, fmt.Sprintf("/api/v1/admin/servers/%s/test", created.ID.ValueString())); got != 3 {
+			t.Fatalf("expected 3 connection tests, got %d", got)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		fake, c := newTestClient(t)
+		h := newResourceHarness(t, NewServerResource(), c)
+
+		fake.SetAgentOfflineFor("10.0.0.1", -1)
+		state, diags := h.tryCreate(waitingServerPlan("20ms"))
+		requireDiagnostics(t, diags, "Server did not come online", "")
+		if state.Raw.IsNull() {
+			t.Fatal("expected the registered server to be kept in state")
+		}
+	})
+
+	t.Run("invalid timeout", func(t *testing.T) {
+		_, c := newTestClient(t)
+		h := newResourceHarness(t, NewServerResource(), c)
+
+		requireDiagnostics(t, h.validateConfig(waitingServerPlan("soon")), "Invalid duration", "")
+	})
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &ServersDataSource{}
+
+func NewServersDataSource() datasource.DataSource {
+	return &ServersDataSource{}
+}
+
+type ServersDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type ServersDataSourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	NameRegex types.String         `tfsdk:"name_regex"`
+	IsActive  types.Bool           `tfsdk:"is_active"`
+	IDs       []types.Int64        `tfsdk:"ids"`
+	Servers   []ServerSummaryModel `tfsdk:"servers"`
+}
+
+type ServerSummaryModel struct {
+	ID                  types.Int64  `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Host                types.String `tfsdk:"host"`
+	Port                types.Int64  `tfsdk:"port"`
+	IsActive            types.Bool   `tfsdk:"is_active"`
+	SkipSSLVerification types.Bool   `tfsdk:"skip_ssl_verification"`
+}
+
+func (d *ServersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *ServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists servers registered in Berth, optionally filtered",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Regular expression (RE2) that server names must match",
+				Optional:    true,
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Only return servers with this active state",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching servers, usable as permission_set server_ids",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"servers": schema.ListNestedAttribute{
+				Description: "Matching servers",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "Server ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Server name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Server description",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "Hostname or IP address of the Berth agent",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port of the Berth agent",
+							Computed:    true,
+						},
+						"is_active": schema.BoolAttribute{
+							Description: "Whether the server is active",
+							Computed:    true,
+						},
+						"skip_ssl_verification": schema.BoolAttribute{
+							Description: "Whether TLS certificate verification is skipped for the agent",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ServersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *ServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ServersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+		return
+	}
+
+	data.IDs = make([]types.Int64, 0, len(servers))
+	data.Servers = make([]ServerSummaryModel, 0, len(servers))
+	for _, server := range servers {
+		if nameRegex != nil && !nameRegex.MatchString(server.Name) {
+			continue
+		}
+		if !data.IsActive.IsNull() && data.IsActive.ValueBool() != server.IsActive {
+			continue
+		}
+
+		data.IDs = append(data.IDs, types.Int64Value(int64(server.ID)))
+		data.Servers = append(data.Servers, ServerSummaryModel{
+			ID:                  types.Int64Value(int64(server.ID)),
+			Name:                types.StringValue(server.Name),
+			Description:         types.StringValue(server.Description),
+			Host:                types.StringValue(server.Host),
+			Port:                types.Int64Value(int64(server.Port)),
+			IsActive:            types.BoolValue(server.IsActive),
+			SkipSSLVerification: types.BoolValue(server.SkipSSLVerification),
+		})
+	}
+
+	data.ID = types.StringValue("servers")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestServersDataSource_Filters(t *testing.T) {
+	fake, c := newTestClient(t)
+	prodA := fake.AddServer("prod-a", "10.0.0.1", 8081)
+	fake.AddServer("staging", "10.0.1.1", 8081)
+	prodB := fake.AddServer("prod-b", "10.0.0.2", 8081)
+	fake.SetServerActive(prodB.ID, false)
+
+	tests := []struct {
+		name      string
+		nameRegex types.String
+		isActive  types.Bool
+		wantNames []string
+	}{
+		{name: "all", nameRegex: types.StringNull(), isActive: types.BoolNull(), wantNames: []string{"prod-a", "staging", "prod-b"}},
+		{name: "name_regex", nameRegex: types.StringValue("^prod-"), isActive: types.BoolNull(), wantNames: []string{"prod-a", "prod-b"}},
+		{name: "is_active", nameRegex: types.StringNull(), isActive: types.BoolValue(false), wantNames: []string{"prod-b"}},
+		{name: "combined", nameRegex: types.StringValue("^prod-"), isActive: types.BoolValue(true), wantNames: []string{"prod-a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, diags := readDataSource(t, NewServersDataSource(), c, ServersDataSourceModel{
+				ID:        types.StringNull(),
+				NameRegex: tt.nameRegex,
+				IsActive:  tt.isActive,
+			})
+			requireNoDiags(t, diags)
+
+			var data ServersDataSourceModel
+			requireNoDiags(t, state.Get(context.Background(), &data))
+			if len(data.Servers) != len(tt.wantNames) || len(data.IDs) != len(tt.wantNames) {
+				t.Fatalf("expected servers %v, got %+v", tt.wantNames, data.Servers)
+			}
+			for i, name := range tt.wantNames {
+				if data.Servers[i].Name.ValueString() != name || data.IDs[i] != data.Servers[i].ID {
+					t.Fatalf("expected servers %v, got %+v", tt.wantNames, data.Servers)
+				}
+			}
+		})
+	}
+
+	state, diags := readDataSource(t, NewServersDataSource(), c, ServersDataSourceModel{
+		ID:        types.StringNull(),
+		NameRegex: types.StringValue("^prod-a$"),
+		IsActive:  types.BoolNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data ServersDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.Servers[0].ID.ValueInt64() != int64(prodA.ID) || data.Servers[0].Host.ValueString() != "10.0.0.1" || data.Servers[0].Port.ValueInt64() != 8081 {
+		t.Fatalf("unexpected server details: %+v", data.Servers[0])
+	}
+}
+
+func TestServersDataSource_InvalidNameRegex(t *testing.T) {
+	_, c := newTestClient(t)
+
+	_, diags := readDataSource(t, NewServersDataSource(), c, ServersDataSourceModel{
+		ID:        types.StringNull(),
+		NameRegex: types.StringValue("("),
+		IsActive:  types.BoolNull(),
+	})
+	requireDiagnostics(t, diags, "Invalid name_regex", "")
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &StackDriftDataSource{}
+
+func NewStackDriftDataSource() datasource.DataSource {
+	return &StackDriftDataSource{}
+}
+
+type StackDriftDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackDriftDataSourceModel struct {
+	ID                 types.String      `tfsdk:"id"`
+	ServerID           types.Int64       `tfsdk:"server_id"`
+	StackName          types.String      `tfsdk:"stack_name"`
+	HasDrift           types.Bool        `tfsdk:"has_drift"`
+	MissingServices    []types.String    `tfsdk:"missing_services"`
+	UndeclaredServices []types.String    `tfsdk:"undeclared_services"`
+	StoppedContainers  []types.String    `tfsdk:"stopped_containers"`
+	ChangedImages      []ImageDriftModel `tfsdk:"changed_images"`
+}
+
+type ImageDriftModel struct {
+	Service       types.String `tfsdk:"service"`
+	ContainerName types.String `tfsdk:"container_name"`
+	DesiredImage  types.String `tfsdk:"desired_image"`
+	RunningImage  types.String `tfsdk:"running_image"`
+}
+
+func (d *StackDriftDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_drift"
+}
+
+func (d *StackDriftDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Compares a stack's compose definition in Berth with its running containers",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier in format 'server_id:stack_name'",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+			},
+			"has_drift": schema.BoolAttribute{
+				Description: "Whether any drift was detected",
+				Computed:    true,
+			},
+			"missing_services": schema.ListAttribute{
+				Description: "Services defined in the compose file with no containers",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"undeclared_services": schema.ListAttribute{
+				Description: "Services with containers that are not defined in the compose file",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"stopped_containers": schema.ListAttribute{
+				Description: "Containers of defined services that are not running",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"changed_images": schema.ListNestedAttribute{
+				Description: "Containers running an image other than the one in the compose file",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Description: "Compose service name",
+							Computed:    true,
+						},
+						"container_name": schema.StringAttribute{
+							Description: "Container name",
+							Computed:    true,
+						},
+						"desired_image": schema.StringAttribute{
+							Description: "Image in the compose file",
+							Computed:    true,
+						},
+						"running_image": schema.StringAttribute{
+							Description: "Image of the running container",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StackDriftDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *StackDriftDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StackDriftDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.StackName.ValueString()
+
+	desired, err := d.client.GetComposeServiceImages(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read compose configuration", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	services, err := d.client.GetStackServices(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	data.MissingServices = make([]types.String, 0)
+	data.UndeclaredServices = make([]types.String, 0)
+	data.StoppedContainers = make([]types.String, 0)
+	data.ChangedImages = make([]ImageDriftModel, 0)
+
+	running := make(map[string]bool, len(services))
+	for _, service := range services {
+		if len(service.Containers) > 0 {
+			running[service.Name] = true
+		}
+	}
+
+	declared := make([]string, 0, len(desired))
+	for name := range desired {
+		declared = append(declared, name)
+	}
+	sort.Strings(declared)
+
+	for _, name := range declared {
+		if !running[name] {
+			data.MissingServices = append(data.MissingServices, types.StringValue(name))
+		}
+	}
+
+	for _, service := range services {
+		desiredImage, ok := desired[service.Name]
+		if !ok {
+			if len(service.Containers) > 0 {
+				data.UndeclaredServices = append(data.UndeclaredServices, types.StringValue(service.Name))
+			}
+			continue
+		}
+
+		for _, container := range service.Containers {
+			if container.State != "running" {
+				data.StoppedContainers = append(data.StoppedContainers, types.StringValue(container.Name))
+			}
+
+			if desiredImage != "" && !strings.Contains(desiredImage, "$") && normalizeImageReference(desiredImage) != normalizeImageReference(container.Image) {
+				data.ChangedImages = append(data.ChangedImages, ImageDriftModel{
+					Service:       types.StringValue(service.Name),
+					ContainerName: types.StringValue(container.Name),
+					DesiredImage:  types.StringValue(desiredImage),
+					RunningImage:  types.StringValue(container.Image),
+				})
+			}
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, stackName))
+	data.HasDrift = types.BoolValue(len(data.MissingServices) > 0 || len(data.UndeclaredServices) > 0 ||
+		len(data.StoppedContainers) > 0 || len(data.ChangedImages) > 0)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func normalizeImageReference(image string) string {
+	image = strings.TrimPrefix(image, "docker.io/")
+	image = strings.TrimPrefix(image, "library/")
+
+	if strings.Contains(image, "@") {
+		return image
+	}
+	if strings.LastIndex(image, ":") <= strings.LastIndex(image, "/") {
+		image += ":latest"
+	}
+	return image
+}
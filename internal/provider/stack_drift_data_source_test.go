@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func stackDriftModel(serverID int32, stackName string) StackDriftDataSourceModel {
+	return StackDriftDataSourceModel{
+		ID:        types.StringNull(),
+		ServerID:  types.Int64Value(int64(serverID)),
+		StackName: types.StringValue(stackName),
+		HasDrift:  types.BoolNull(),
+	}
+}
+
+func stringValues(values []types.String) []string {
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.ValueString())
+	}
+	return out
+}
+
+func TestStackDriftDataSource(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{
+		Name: "web",
+		Services: []berthtest.Service{
+			{Name: "app", Containers: []berthtest.Container{
+				{Name: "web-app-1", Image: "nginx:1.26", State: "running"},
+				{Name: "web-app-2", Image: "nginx:1.27", State: "exited"},
+			}},
+			{Name: "sidecar", Containers: []berthtest.Container{{Name: "web-sidecar-1", Image: "busybox", State: "running"}}},
+			{Name: "db"},
+		},
+		Compose: map[string]string{
+			"app":    "nginx:1.27",
+			"db":     "postgres:16",
+			"cache":  "redis:7",
+			"worker": "${WORKER_IMAGE}",
+		},
+	})
+
+	state, diags := readDataSource(t, NewStackDriftDataSource(), c, stackDriftModel(server.ID, "web"))
+	requireNoDiags(t, diags)
+
+	var data StackDriftDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if !data.HasDrift.ValueBool() {
+		t.Fatal("expected drift")
+	}
+	if got := stringValues(data.MissingServices); len(got) != 3 || got[0] != "cache" || got[1] != "db" || got[2] != "worker" {
+		t.Fatalf("expected sorted missing services, got %v", got)
+	}
+	if got := stringValues(data.UndeclaredServices); len(got) != 1 || got[0] != "sidecar" {
+		t.Fatalf("unexpected undeclared services: %v", got)
+	}
+	if got := stringValues(data.StoppedContainers); len(got) != 1 || got[0] != "web-app-2" {
+		t.Fatalf("unexpected stopped containers: %v", got)
+	}
+	if len(data.ChangedImages) != 1 {
+		t.Fatalf("expected one changed image, got %+v", data.ChangedImages)
+	}
+	if changed := data.ChangedImages[0]; changed.ContainerName.ValueString() != "web-app-1" || changed.DesiredImage.ValueString() != "nginx:1.27" || changed.RunningImage.ValueString() != "nginx:1.26" {
+		t.Fatalf("unexpected changed image: %+v", changed)
+	}
+	if data.ID.ValueString() != fmt.Sprintf("%d:web", server.ID) {
+		t.Fatalf("unexpected id: %s", data.ID)
+	}
+}
+
+func TestStackDriftDataSource_NoDrift(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{
+		Name: "web",
+		Services: []berthtest.Service{
+			{Name: "app", Containers: []berthtest.Container{{Name: "web-app-1", Image: "docker.io/library/nginx:latest", State: "running"}}},
+			{Name: "cache", Containers: []berthtest.Container{{Name: "web-cache-1", Image: "redis@sha256:abc", State: "running"}}},
+		},
+		Compose: map[string]string{
+			"app":   "nginx",
+			"cache": "redis@sha256:abc",
+		},
+	})
+
+	state, diags := readDataSource(t, NewStackDriftDataSource(), c, stackDriftModel(server.ID, "web"))
+	requireNoDiags(t, diags)
+
+	var data StackDriftDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.HasDrift.ValueBool() {
+		t.Fatalf("expected no drift, got %+v", data)
+	}
+	if data.MissingServices == nil || data.UndeclaredServices == nil || data.StoppedContainers == nil || data.ChangedImages == nil {
+		t.Fatalf("expected empty lists rather than null, got %+v", data)
+	}
+}
+
+func TestStackDriftDataSource_MissingStack(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+
+	_, diags := readDataSource(t, NewStackDriftDataSource(), c, stackDriftModel(server.ID, "web"))
+	requireDiagnostics(t, diags, "Failed to read compose configuration", "")
+}
+
+func TestNormalizeImageReference(t *testing.T) {
+	tests := map[string]string{
+		"nginx":                           "nginx:latest",
+		"nginx:1.27":                      "nginx:1.27",
+		"docker.io/library/nginx":         "nginx:latest",
+		"docker.io/bitnami/redis:7":       "bitnami/redis:7",
+		"registry.example.com:5000/app":   "registry.example.com:5000/app:latest",
+		"registry.example.com:5000/app:1": "registry.example.com:5000/app:1",
+		"redis@sha256:abc":                "redis@sha256:abc",
+	}
+
+	for image, want := range tests {
+		if got := normalizeImageReference(image); got != want {
+			t.Errorf("normalizeImageReference(%q): expected %q, got %q", image, want, got)
+		}
+	}
+}
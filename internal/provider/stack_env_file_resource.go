@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const stackEnvFilePath = ".env"
+
+var _ resource.Resource = &StackEnvFileResource{}
+var _ resource.ResourceWithImportState = &StackEnvFileResource{}
+var _ resource.ResourceWithModifyPlan = &StackEnvFileResource{}
+
+func NewStackEnvFileResource() resource.Resource {
+	return &StackEnvFileResource{}
+}
+
+type StackEnvFileResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackEnvFileResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	ServerID      types.Int64  `tfsdk:"server_id"`
+	StackName     types.String `tfsdk:"stack_name"`
+	Content       types.String `tfsdk:"content"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+}
+
+func (r *StackEnvFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_env_file"
+}
+
+func (r *StackEnvFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the raw .env file of a Berth stack. It refuses to overwrite a .env file managed by berth_stack_environment",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource ID in format 'server_id:stack_name'",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Raw content of the stack's .env file",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the .env file content, used for drift detection",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *StackEnvFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *StackEnvFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFeatureSupported(ctx, r.providerData, "berth_stack_env_file", client.FeatureStackFiles)...)
+
+	var content types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content"), &content)...)
+	if resp.Diagnostics.HasError() || content.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), contentSHA256(content.ValueString()))...)
+}
+
+func (r *StackEnvFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StackEnvFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.StackName.ValueString()
+
+	resp.Diagnostics.Append(r.checkNotManagedByEnvironment(ctx, serverID, stackName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.WriteStackFile(ctx, serverID, stackName, stackEnvFilePath, data.Content.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to write stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, stackName))
+	data.ContentSHA256 = types.StringValue(contentSHA256(data.Content.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StackEnvFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.ReadStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath)
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+
+	data.Content = types.StringValue(content)
+	data.ContentSHA256 = types.StringValue(contentSHA256(content))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StackEnvFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.checkNotManagedByEnvironment(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.WriteStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath, data.Content.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to write stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+
+	data.ContentSHA256 = types.StringValue(contentSHA256(data.Content.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StackEnvFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+}
+
+func (r *StackEnvFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'server_id:stack_name'",
+		)
+		return
+	}
+
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stack_name"), parts[1])...)
+}
+
+func (r *StackEnvFileResource) checkNotManagedByEnvironment(ctx context.Context, serverID uint, stackName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content, err := r.client.ReadStackFile(ctx, serverID, stackName, stackEnvFilePath)
+	switch {
+	case client.IsNotFound(err):
+	case err != nil:
+		diags.AddError("Failed to read stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", stackName, serverID), err))
+	case isStackEnvironmentFile(content):
+		diags.AddAttributeError(
+			path.Root("stack_name"),
+			"Stack env file managed by berth_stack_environment",
+			fmt.Sprintf("The .env file of stack '%s' on server %d is managed by a berth_stack_environment resource. Manage it with one resource only.", stackName, serverID),
+		)
+	}
+
+	return diags
+}
+
+func contentSHA256(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func TestStackEnvFileResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	state := h.create(StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("LOG_LEVEL=info\n"),
+		ContentSHA256: types.StringUnknown(),
+	})
+
+	var created StackEnvFileResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() != fmt.Sprintf("%d:web", server.ID) {
+		t.Fatalf("unexpected id %s", created.ID.ValueString())
+	}
+	if content, _ := fake.StackFile(server.ID, "web", ".env"); content != "LOG_LEVEL=info\n" {
+		t.Fatalf("expected env file to be written, got %q", content)
+	}
+
+	created.Content = types.StringValue("LOG_LEVEL=debug\n")
+	state = h.update(state, created)
+	if content, _ := fake.StackFile(server.ID, "web", ".env"); content != "LOG_LEVEL=debug\n" {
+		t.Fatalf("expected env file to be updated, got %q", content)
+	}
+
+	h.delete(state)
+	if _, ok := fake.StackFile(server.ID, "web", ".env"); ok {
+		t.Fatal("expected env file to be deleted")
+	}
+}
+
+func TestStackEnvFileResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "api"})
+	fake.SetStackFile(server.ID, "api", ".env", "PORT=8080\n")
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:api", server.ID))
+
+	var imported StackEnvFileResourceModel
+	h.get(state, &imported)
+	if imported.Content.ValueString() != "PORT=8080\n" || imported.ContentSHA256.ValueString() != contentSHA256("PORT=8080\n") {
+		t.Fatalf("unexpected imported env file: %+v", imported)
+	}
+}
+
+func TestStackEnvFileResource_Drift(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	state := h.create(StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("LOG_LEVEL=info\n"),
+		ContentSHA256: types.StringUnknown(),
+	})
+
+	fake.SetStackFile(server.ID, "web", ".env", "LOG_LEVEL=info\nDEBUG=true\n")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var drifted StackEnvFileResourceModel
+	h.get(state, &drifted)
+	if drifted.Content.ValueString() != "LOG_LEVEL=info\nDEBUG=true\n" {
+		t.Fatalf("expected out-of-band edit to show up in state, got %q", drifted.Content.ValueString())
+	}
+	if drifted.ContentSHA256.ValueString() == contentSHA256("LOG_LEVEL=info\n") {
+		t.Fatal("expected content hash to change after an out-of-band edit")
+	}
+}
+
+func TestStackEnvFileResource_ReadMissingFile(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	fake.SetStackFile(server.ID, "web", ".env", "PORT=8080\n")
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:web", server.ID))
+	fake.RemoveStackFile(server.ID, "web", ".env")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected a missing env file to remove the resource from state")
+	}
+}
+
+func TestStackEnvFileResource_PlansContentHash(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	model := StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("LOG_LEVEL=info\n"),
+		ContentSHA256: types.StringUnknown(),
+	}
+	plan, diags := h.modifiedPlanFrom(h.emptyState(), model)
+	requireNoDiags(t, diags)
+
+	var planned StackEnvFileResourceModel
+	requireNoDiags(t, plan.Get(context.Background(), &planned))
+	if planned.ContentSHA256.ValueString() != contentSHA256("LOG_LEVEL=info\n") {
+		t.Fatalf("expected content_sha256 to be planned from content, got %s", planned.ContentSHA256)
+	}
+
+	model.Content = types.StringUnknown()
+	plan, diags = h.modifiedPlanFrom(h.emptyState(), model)
+	requireNoDiags(t, diags)
+	requireNoDiags(t, plan.Get(context.Background(), &planned))
+	if !planned.ContentSHA256.IsUnknown() {
+		t.Fatalf("expected content_sha256 to stay unknown for unknown content, got %s", planned.ContentSHA256)
+	}
+}
+
+func TestStackEnvFileResource_DeleteMissingFile(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvFileResource(), c)
+
+	state := h.create(StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("LOG_LEVEL=info\n"),
+		ContentSHA256: types.StringUnknown(),
+	})
+
+	fake.RemoveStackFile(server.ID, "web", ".env")
+	h.delete(state)
+}
@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+const stackEnvironmentHeader = "# Managed by Terraform (berth_stack_environment). Do not edit."
+
+var envVariableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var _ resource.Resource = &StackEnvironmentResource{}
+var _ resource.ResourceWithImportState = &StackEnvironmentResource{}
+var _ resource.ResourceWithModifyPlan = &StackEnvironmentResource{}
+var _ resource.ResourceWithValidateConfig = &StackEnvironmentResource{}
+
+func NewStackEnvironmentResource() resource.Resource {
+	return &StackEnvironmentResource{}
+}
+
+type StackEnvironmentResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackEnvironmentResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ServerID           types.Int64  `tfsdk:"server_id"`
+	StackName          types.String `tfsdk:"stack_name"`
+	Variables          types.Map    `tfsdk:"variables"`
+	SensitiveVariables types.Map    `tfsdk:"sensitive_variables"`
+	ContentSHA256      types.String `tfsdk:"content_sha256"`
+}
+
+func (r *StackEnvironmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_environment"
+}
+
+func (r *StackEnvironmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the environment variables of a Berth stack by writing its .env file. The resource owns the whole file: creation fails if the stack already has a .env file written by something else, which can be imported instead, and berth_stack_env_file refuses to overwrite a file managed by this resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource ID in format 'server_id:stack_name'",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Description: "Environment variables written to the .env file. Variables found in the file but not configured show up as drift",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"sensitive_variables": schema.MapAttribute{
+				Description: "Environment variables whose values are hidden from plan output. A name must not appear in both variables and sensitive_variables",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the rendered .env file, used for drift detection",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *StackEnvironmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *StackEnvironmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data StackEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attribute := range []struct {
+		name      string
+		variables types.Map
+	}{
+		{"variables", data.Variables},
+		{"sensitive_variables", data.SensitiveVariables},
+	} {
+		for name := range attribute.variables.Elements() {
+			if !envVariableNamePattern.MatchString(name) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name).AtMapKey(name),
+					"Invalid environment variable name",
+					fmt.Sprintf("%q is not a valid variable name. Names must start with a letter or underscore and contain only letters, digits and underscores.", name),
+				)
+			}
+		}
+	}
+
+	for name := range data.SensitiveVariables.Elements() {
+		if _, ok := data.Variables.Elements()[name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("sensitive_variables").AtMapKey(name),
+				"Duplicate environment variable",
+				fmt.Sprintf("%q is set in both variables and sensitive_variables.", name),
+			)
+		}
+	}
+}
+
+func (r *StackEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFeatureSupported(ctx, r.providerData, "berth_stack_environment", client.FeatureStackFiles)...)
+}
+
+func (r *StackEnvironmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StackEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := r.client.ReadStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath)
+	switch {
+	case client.IsNotFound(err):
+	case err != nil:
+		resp.Diagnostics.AddError("Failed to read stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	case !isStackEnvironmentFile(existing):
+		resp.Diagnostics.AddAttributeError(
+			path.Root("stack_name"),
+			"Stack env file already exists",
+			fmt.Sprintf("Stack '%s' on server %d already has a .env file that is not managed by berth_stack_environment. Import it with 'terraform import' to take it over, or remove the berth_stack_env_file resource that manages it.", data.StackName.ValueString(), data.ServerID.ValueInt64()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", data.ServerID.ValueInt64(), data.StackName.ValueString()))
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvironmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StackEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := r.client.ReadStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath)
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+
+	sensitive := data.SensitiveVariables.Elements()
+	variables := make(map[string]string)
+	sensitiveVariables := make(map[string]string)
+	for name, value := range parseEnvFile(content) {
+		if _, ok := sensitive[name]; ok {
+			sensitiveVariables[name] = value
+		} else {
+			variables[name] = value
+		}
+	}
+
+	resp.Diagnostics.Append(envVariablesValue(ctx, variables, data.Variables, &data.Variables)...)
+	resp.Diagnostics.Append(envVariablesValue(ctx, sensitiveVariables, data.SensitiveVariables, &data.SensitiveVariables)...)
+	data.ContentSHA256 = types.StringValue(contentSHA256(content))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvironmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StackEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackEnvironmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StackEnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Failed to delete stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+}
+
+func (r *StackEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'server_id:stack_name'",
+		)
+		return
+	}
+
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("stack_name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variables"), types.MapNull(types.StringType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sensitive_variables"), types.MapNull(types.StringType))...)
+}
+
+func (r *StackEnvironmentResource) write(ctx context.Context, data *StackEnvironmentResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	variables := make(map[string]string)
+	for _, m := range []types.Map{data.Variables, data.SensitiveVariables} {
+		for name, value := range m.Elements() {
+			if s, ok := value.(types.String); ok {
+				variables[name] = s.ValueString()
+			}
+		}
+	}
+
+	content := stackEnvironmentHeader + "\n" + renderEnvFile(variables)
+	if err := r.client.WriteStackFile(ctx, uint(data.ServerID.ValueInt64()), data.StackName.ValueString(), stackEnvFilePath, content); err != nil {
+		diags.AddError("Failed to write stack env file", apiErrorDetail(r.providerData, fmt.Sprintf("Env file of stack %q on server %d", data.StackName.ValueString(), data.ServerID.ValueInt64()), err))
+		return diags
+	}
+
+	data.ContentSHA256 = types.StringValue(contentSHA256(content))
+	return diags
+}
+
+func envVariablesValue(ctx context.Context, variables map[string]string, prior types.Map, target *types.Map) diag.Diagnostics {
+	if len(variables) == 0 && prior.IsNull() {
+		*target = types.MapNull(types.StringType)
+		return nil
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, variables)
+	*target = value
+	return diags
+}
+
+func isStackEnvironmentFile(content string) bool {
+	return strings.HasPrefix(content, stackEnvironmentHeader+"\n")
+}
+
+func renderEnvFile(variables map[string]string) string {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(quoteEnvValue(variables[name]))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func quoteEnvValue(value string) string {
+	if value == "" || strings.IndexFunc(value, needsEnvQuoting) < 0 {
+		return value
+	}
+	if !strings.ContainsAny(value, "'\n") {
+		return "'" + value + "'"
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "$", "$$")
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func needsEnvQuoting(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	}
+	return !strings.ContainsRune("_-.,:/@%+=", r)
+}
+
+func parseEnvFile(content string) map[string]string {
+	variables := make(map[string]string)
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		variables[strings.TrimSpace(name)] = unquoteEnvValue(strings.TrimSpace(value))
+	}
+
+	return variables
+}
+
+func unquoteEnvValue(value string) string {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return value[1 : len(value)-1]
+	}
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		replacer := strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", "$$", "$")
+		return replacer.Replace(value[1 : len(value)-1])
+	}
+	if i := strings.Index(value, " #"); i >= 0 {
+		return strings.TrimSpace(value[:i])
+	}
+	return value
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+func stringMap(t *testing.T, values map[string]string) types.Map {
+	t.Helper()
+
+	m, diags := types.MapValueFrom(context.Background(), types.StringType, values)
+	requireNoDiags(t, diags)
+	return m
+}
+
+func TestStackEnvironmentResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvironmentResource(), c)
+
+	state := h.create(StackEnvironmentResourceModel{
+		ID:                 types.StringUnknown(),
+		ServerID:           types.Int64Value(int64(server.ID)),
+		StackName:          types.StringValue("web"),
+		Variables:          stringMap(t, map[string]string{"LOG_LEVEL": "info", "GREETING": "hello world"}),
+		SensitiveVariables: stringMap(t, map[string]string{"DB_PASSWORD": "p@ss'word"}),
+		ContentSHA256:      types.StringUnknown(),
+	})
+
+	want := stackEnvironmentHeader + "\nDB_PASSWORD=\"p@ss'word\"\nGREETING='hello world'\nLOG_LEVEL=info\n"
+	if got, _ := fake.StackFile(server.ID, "web", ".env"); got != want {
+		t.Fatalf("expected env file %q, got %q", want, got)
+	}
+
+	var created StackEnvironmentResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() != fmt.Sprintf("%d:web", server.ID) {
+		t.Fatalf("unexpected id %s", created.ID.ValueString())
+	}
+	if created.ContentSHA256.ValueString() != contentSHA256(want) {
+		t.Fatalf("expected content hash of rendered file, got %s", created.ContentSHA256.ValueString())
+	}
+
+	created.Variables = stringMap(t, map[string]string{"LOG_LEVEL": "debug"})
+	state = h.update(state, created)
+	if got, _ := fake.StackFile(server.ID, "web", ".env"); got != stackEnvironmentHeader+"\nDB_PASSWORD=\"p@ss'word\"\nLOG_LEVEL=debug\n" {
+		t.Fatalf("unexpected env file after update: %q", got)
+	}
+
+	h.delete(state)
+	if _, ok := fake.StackFile(server.ID, "web", ".env"); ok {
+		t.Fatal("expected env file to be deleted")
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected missing env file to remove the resource from state")
+	}
+}
+
+func TestStackEnvironmentResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "api"})
+	fake.SetStackFile(server.ID, "api", ".env", "TOKEN=abc\nPORT=8080\n")
+	h := newResourceHarness(t, NewStackEnvironmentResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:api", server.ID))
+
+	var imported StackEnvironmentResourceModel
+	h.get(state, &imported)
+	if imported.ServerID.ValueInt64() != int64(server.ID) || imported.StackName.ValueString() != "api" {
+		t.Fatalf("unexpected imported identity: %d %s", imported.ServerID.ValueInt64(), imported.StackName.ValueString())
+	}
+	if got := imported.Variables.Elements(); len(got) != 2 || got["PORT"] != types.StringValue("8080") {
+		t.Fatalf("expected imported variables, got %v", got)
+	}
+	if !imported.SensitiveVariables.IsNull() {
+		t.Fatalf("expected sensitive_variables to stay null after import, got %v", imported.SensitiveVariables)
+	}
+}
+
+func TestStackEnvironmentResource_Drift(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	h := newResourceHarness(t, NewStackEnvironmentResource(), c)
+
+	state := h.create(StackEnvironmentResourceModel{
+		ID:                 types.StringUnknown(),
+		ServerID:           types.Int64Value(int64(server.ID)),
+		StackName:          types.StringValue("web"),
+		Variables:          stringMap(t, map[string]string{"LOG_LEVEL": "info"}),
+		SensitiveVariables: stringMap(t, map[string]string{"DB_PASSWORD": "secret"}),
+		ContentSHA256:      types.StringUnknown(),
+	})
+
+	content, _ := fake.StackFile(server.ID, "web", ".env")
+	fake.SetStackFile(server.ID, "web", ".env", content+"# added by hand\nexport DEBUG=true\n")
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var read StackEnvironmentResourceModel
+	h.get(state, &read)
+	if got := read.Variables.Elements(); len(got) != 2 || got["DEBUG"] != types.StringValue("true") {
+		t.Fatalf("expected hand-added variable to show as drift, got %v", got)
+	}
+	if got := read.SensitiveVariables.Elements(); len(got) != 1 || got["DB_PASSWORD"] != types.StringValue("secret") {
+		t.Fatalf("expected sensitive variables to stay sensitive, got %v", got)
+	}
+}
+
+func TestStackEnvironmentResource_ConflictsWithEnvFile(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "web"})
+	fake.AddStack(server.ID, berthtest.Stack{Name: "api"})
+
+	envFile := newResourceHarness(t, NewStackEnvFileResource(), c)
+	environment := newResourceHarness(t, NewStackEnvironmentResource(), c)
+
+	envFile.create(StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("web"),
+		Content:       types.StringValue("PORT=8080\n"),
+		ContentSHA256: types.StringUnknown(),
+	})
+
+	_, diags := environment.tryCreate(StackEnvironmentResourceModel{
+		ID:                 types.StringUnknown(),
+		ServerID:           types.Int64Value(int64(server.ID)),
+		StackName:          types.StringValue("web"),
+		Variables:          stringMap(t, map[string]string{"PORT": "9090"}),
+		SensitiveVariables: types.MapNull(types.StringType),
+		ContentSHA256:      types.StringUnknown(),
+	})
+	requireDiagnostics(t, diags, "Stack env file already exists", "")
+	if content, _ := fake.StackFile(server.ID, "web", ".env"); content != "PORT=8080\n" {
+		t.Fatalf("expected env file to be left alone, got %q", content)
+	}
+
+	environment.create(StackEnvironmentResourceModel{
+		ID:                 types.StringUnknown(),
+		ServerID:           types.Int64Value(int64(server.ID)),
+		StackName:          types.StringValue("api"),
+		Variables:          stringMap(t, map[string]string{"PORT": "9090"}),
+		SensitiveVariables: types.MapNull(types.StringType),
+		ContentSHA256:      types.StringUnknown(),
+	})
+
+	_, diags = envFile.tryCreate(StackEnvFileResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(int64(server.ID)),
+		StackName:     types.StringValue("api"),
+		Content:       types.StringValue("PORT=8080\n"),
+		ContentSHA256: types.StringUnknown(),
+	})
+	requireDiagnostics(t, diags, "Stack env file managed by berth_stack_environment", "")
+	if content, _ := fake.StackFile(server.ID, "api", ".env"); !isStackEnvironmentFile(content) {
+		t.Fatalf("expected env file to be left alone, got %q", content)
+	}
+}
+
+func TestStackEnvironmentResource_ValidateConfig(t *testing.T) {
+	_, c := newTestClient(t)
+	h := newResourceHarness(t, NewStackEnvironmentResource(), c)
+
+	model := StackEnvironmentResourceModel{
+		ID:            types.StringUnknown(),
+		ServerID:      types.Int64Value(1),
+		StackName:     types.StringValue("web"),
+		ContentSHA256: types.StringUnknown(),
+	}
+
+	tests := []struct {
+		name      string
+		variables map[string]string
+		sensitive map[string]string
+		wantError string
+	}{
+		{name: "valid", variables: map[string]string{"A_1": "x"}, sensitive: map[string]string{"_SECRET": "y"}},
+		{name: "invalid name", variables: map[string]string{"1BAD": "x"}, wantError: "Invalid environment variable name"},
+		{name: "duplicate", variables: map[string]string{"TOKEN": "x"}, sensitive: map[string]string{"TOKEN": "y"}, wantError: "Duplicate environment variable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model.Variables = stringMap(t, tt.variables)
+			model.SensitiveVariables = stringMap(t, tt.sensitive)
+			requireDiagnostics(t, h.validateConfig(model), tt.wantError, "")
+		})
+	}
+}
+
+func TestEnvFileRoundTrip(t *testing.T) {
+	variables := map[string]string{
+		"EMPTY":     "",
+		"PLAIN":     "postgres://db:5432/app",
+		"SPACES":    "two words",
+		"HASH":      "a#b",
+		"QUOTES":    `it's "quoted"`,
+		"MULTILINE": "line one\nline two",
+		"BACKSLASH": `C:\path\`,
+		"DOLLAR":    "pa$$word",
+		"INTERP":    `it's ${HOME}`,
+	}
+
+	got := parseEnvFile(renderEnvFile(variables))
+	if len(got) != len(variables) {
+		t.Fatalf("expected %d variables, got %v", len(variables), got)
+	}
+	for name, want := range variables {
+		if got[name] != want {
+			t.Errorf("%s: expected %q, got %q", name, want, got[name])
+		}
+	}
+}
+
+func TestQuoteEnvValue_EscapesInterpolation(t *testing.T) {
+	tests := map[string]string{
+		"plain":      "plain",
+		"$HOME":      "'$HOME'",
+		`it's $HOME`: `"it's $$HOME"`,
+		"a\n$b":      `"a\n$$b"`,
+	}
+
+	for value, want := range tests {
+		if got := quoteEnvValue(value); got != want {
+			t.Errorf("quoteEnvValue(%q) = %s, want %s", value, got, want)
+		}
+	}
+}
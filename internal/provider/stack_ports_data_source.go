@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &StackPortsDataSource{}
+
+func NewStackPortsDataSource() datasource.DataSource {
+	return &StackPortsDataSource{}
+}
+
+type StackPortsDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackPortsDataSourceModel struct {
+	ID        types.String         `tfsdk:"id"`
+	ServerID  types.Int64          `tfsdk:"server_id"`
+	StackName types.String         `tfsdk:"stack_name"`
+	Ports     []PublishedPortModel `tfsdk:"ports"`
+}
+
+type PublishedPortModel struct {
+	StackName     types.String `tfsdk:"stack_name"`
+	Service       types.String `tfsdk:"service"`
+	ContainerName types.String `tfsdk:"container_name"`
+	HostPort      types.Int64  `tfsdk:"host_port"`
+	ContainerPort types.Int64  `tfsdk:"container_port"`
+	Protocol      types.String `tfsdk:"protocol"`
+}
+
+func (d *StackPortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_ports"
+}
+
+func (d *StackPortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Returns every published port of a stack, or of all stacks on a server",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name. If unset, ports of every stack on the server are returned",
+				Optional:    true,
+			},
+			"ports": schema.ListNestedAttribute{
+				Description: "Published ports",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"stack_name": schema.StringAttribute{
+							Description: "Stack name",
+							Computed:    true,
+						},
+						"service": schema.StringAttribute{
+							Description: "Compose service name",
+							Computed:    true,
+						},
+						"container_name": schema.StringAttribute{
+							Description: "Container name",
+							Computed:    true,
+						},
+						"host_port": schema.Int64Attribute{
+							Description: "Port published on the host",
+							Computed:    true,
+						},
+						"container_port": schema.Int64Attribute{
+							Description: "Port inside the container",
+							Computed:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "Port protocol (e.g., 'tcp', 'udp')",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StackPortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *StackPortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StackPortsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+
+	var stackNames []string
+	if !data.StackName.IsNull() {
+		stackNames = []string{data.StackName.ValueString()}
+		data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, data.StackName.ValueString()))
+	} else {
+		stacks, err := d.client.ListStacks(ctx, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to list stacks", apiErrorDetail(d.providerData, fmt.Sprintf("Server %d", serverID), err))
+			return
+		}
+		for _, stack := range stacks {
+			stackNames = append(stackNames, stack.Name)
+		}
+		data.ID = types.StringValue(fmt.Sprintf("%d", serverID))
+	}
+
+	data.Ports = make([]PublishedPortModel, 0)
+	for _, stackName := range stackNames {
+		services, err := d.client.GetStackServices(ctx, serverID, stackName)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read stack", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+			return
+		}
+
+		for _, service := range services {
+			for _, container := range service.Containers {
+				for _, port := range container.Ports {
+					if port.Public == 0 {
+						continue
+					}
+
+					data.Ports = append(data.Ports, PublishedPortModel{
+						StackName:     types.StringValue(stackName),
+						Service:       types.StringValue(service.Name),
+						ContainerName: types.StringValue(container.Name),
+						HostPort:      types.Int64Value(int64(port.Public)),
+						ContainerPort: types.Int64Value(int64(port.Private)),
+						Protocol:      types.StringValue(port.Protocol),
+					})
+				}
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
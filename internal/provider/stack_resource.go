@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ resource.Resource = &StackResource{}
+var _ resource.ResourceWithImportState = &StackResource{}
+var _ resource.ResourceWithModifyPlan = &StackResource{}
+
+func NewStackResource() resource.Resource {
+	return &StackResource{}
+}
+
+type StackResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	Name           types.String `tfsdk:"name"`
+	ComposeContent types.String `tfsdk:"compose_content"`
+	ComposeFile    types.String `tfsdk:"compose_file"`
+	Path           types.String `tfsdk:"path"`
+	ComposeSHA256  types.String `tfsdk:"compose_sha256"`
+}
+
+func (r *StackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack"
+}
+
+func (r *StackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Berth stack and its compose file. Berth has no API to delete stacks, so destroying the resource only removes it from state",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource ID in format 'server_id:name'",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"compose_content": schema.StringAttribute{
+				Description: "Content of the stack's compose file",
+				Required:    true,
+			},
+			"compose_file": schema.StringAttribute{
+				Description: "Name of the compose file within the stack directory",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the stack directory on the server",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compose_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the compose file content, used for drift detection",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *StackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *StackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFeatureSupported(ctx, r.providerData, "berth_stack", client.FeatureStackFiles)...)
+}
+
+func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.Name.ValueString()
+
+	if err := r.client.CreateStack(ctx, serverID, stackName); err != nil {
+		resp.Diagnostics.AddError("Failed to create stack", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	stack, err := r.client.GetStack(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read created stack", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	if err := r.client.WriteStackFile(ctx, serverID, stackName, stack.ComposeFile, data.ComposeContent.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to write compose file", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, stackName))
+	data.ComposeFile = types.StringValue(stack.ComposeFile)
+	data.Path = types.StringValue(stack.Path)
+	data.ComposeSHA256 = types.StringValue(contentSHA256(data.ComposeContent.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.Name.ValueString()
+
+	stack, err := r.client.GetStack(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	content, err := r.client.ReadStackFile(ctx, serverID, stackName, stack.ComposeFile)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read compose file", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	data.ComposeContent = types.StringValue(content)
+	data.ComposeFile = types.StringValue(stack.ComposeFile)
+	data.Path = types.StringValue(stack.Path)
+	data.ComposeSHA256 = types.StringValue(contentSHA256(content))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.WriteStackFile(ctx, uint(data.ServerID.ValueInt64()), data.Name.ValueString(), data.ComposeFile.ValueString(), data.ComposeContent.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to write compose file", apiErrorDetail(r.providerData, fmt.Sprintf("Stack %q on server %d", data.Name.ValueString(), data.ServerID.ValueInt64()), err))
+		return
+	}
+
+	data.ComposeSHA256 = types.StringValue(contentSHA256(data.ComposeContent.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StackResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Stack not deleted",
+		fmt.Sprintf("Berth does not support deleting stacks through the API. Stack '%s' was removed from Terraform state but still exists on server %d.", data.Name.ValueString(), data.ServerID.ValueInt64()),
+	)
+}
+
+func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'server_id:name'",
+		)
+		return
+	}
+
+	serverID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid server ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), serverID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/berthtest"
+)
+
+const testComposeContent = "services:\n  web:\n    image: nginx:1.27\n"
+
+func TestStackResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewStackResource(), c)
+
+	state := h.create(StackResourceModel{
+		ID:             types.StringUnknown(),
+		ServerID:       types.Int64Value(int64(server.ID)),
+		Name:           types.StringValue("web"),
+		ComposeContent: types.StringValue(testComposeContent),
+		ComposeFile:    types.StringUnknown(),
+		Path:           types.StringUnknown(),
+		ComposeSHA256:  types.StringUnknown(),
+	})
+
+	var created StackResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() != fmt.Sprintf("%d:web", server.ID) {
+		t.Fatalf("unexpected id %s", created.ID.ValueString())
+	}
+	if created.ComposeFile.ValueString() != "docker-compose.yml" || created.Path.ValueString() != "/opt/compose/web" {
+		t.Fatalf("expected compose file and path from Berth, got %+v", created)
+	}
+	if content, _ := fake.StackFile(server.ID, "web", "docker-compose.yml"); content != testComposeContent {
+		t.Fatalf("expected compose file to be written, got %q", content)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var read StackResourceModel
+	h.get(state, &read)
+	if read.ComposeContent.ValueString() != testComposeContent || read.ComposeSHA256.ValueString() != contentSHA256(testComposeContent) {
+		t.Fatalf("unexpected stack after read: %+v", read)
+	}
+
+	updated := "services:\n  web:\n    image: nginx:1.28\n"
+	read.ComposeContent = types.StringValue(updated)
+	state = h.update(state, read)
+	if content, _ := fake.StackFile(server.ID, "web", "docker-compose.yml"); content != updated {
+		t.Fatalf("expected compose file to be updated, got %q", content)
+	}
+
+	h.delete(state)
+	if content, ok := fake.StackFile(server.ID, "web", "docker-compose.yml"); !ok || content != updated {
+		t.Fatal("expected stack to remain in Berth after delete")
+	}
+}
+
+func TestStackResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	fake.AddStack(server.ID, berthtest.Stack{Name: "api"})
+	fake.SetStackFile(server.ID, "api", "docker-compose.yml", testComposeContent)
+	h := newResourceHarness(t, NewStackResource(), c)
+
+	state := h.importState(fmt.Sprintf("%d:api", server.ID))
+
+	var imported StackResourceModel
+	h.get(state, &imported)
+	if imported.Name.ValueString() != "api" || imported.ComposeContent.ValueString() != testComposeContent {
+		t.Fatalf("unexpected imported stack: %+v", imported)
+	}
+	if imported.ComposeFile.ValueString() != "docker-compose.yml" {
+		t.Fatalf("expected compose file from Berth, got %s", imported.ComposeFile.ValueString())
+	}
+}
+
+func TestStackResource_Drift(t *testing.T) {
+	fake, c := newTestClient(t)
+	server := fake.AddServer("prod", "10.0.0.1", 8081)
+	h := newResourceHarness(t, NewStackResource(), c)
+
+	state := h.create(StackResourceModel{
+		ID:             types.StringUnknown(),
+		ServerID:       types.Int64Value(int64(server.ID)),
+		Name:           types.StringValue("web"),
+		ComposeContent: types.StringValue(testComposeContent),
+		ComposeFile:    types.StringUnknown(),
+		Path:           types.StringUnknown(),
+		ComposeSHA256:  types.StringUnknown(),
+	})
+
+	edited := testComposeContent + "    restart: always\n"
+	fake.SetStackFile(server.ID, "web", "docker-compose.yml", edited)
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	var drifted StackResourceModel
+	h.get(state, &drifted)
+	if drifted.ComposeContent.ValueString() != edited || drifted.ComposeSHA256.ValueString() != contentSHA256(edited) {
+		t.Fatalf("expected out-of-band compose edit to show up in state, got %+v", drifted)
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &StackStatsDataSource{}
+
+func NewStackStatsDataSource() datasource.DataSource {
+	return &StackStatsDataSource{}
+}
+
+type StackStatsDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type StackStatsDataSourceModel struct {
+	ID               types.String          `tfsdk:"id"`
+	ServerID         types.Int64           `tfsdk:"server_id"`
+	StackName        types.String          `tfsdk:"stack_name"`
+	TotalCPUPercent  types.Float64         `tfsdk:"total_cpu_percent"`
+	TotalMemoryUsage types.Int64           `tfsdk:"total_memory_usage"`
+	Containers       []ContainerStatsModel `tfsdk:"containers"`
+}
+
+type ContainerStatsModel struct {
+	Name            types.String  `tfsdk:"name"`
+	ServiceName     types.String  `tfsdk:"service_name"`
+	CPUPercent      types.Float64 `tfsdk:"cpu_percent"`
+	MemoryUsage     types.Int64   `tfsdk:"memory_usage"`
+	MemoryLimit     types.Int64   `tfsdk:"memory_limit"`
+	MemoryPercent   types.Float64 `tfsdk:"memory_percent"`
+	NetworkRxBytes  types.Int64   `tfsdk:"network_rx_bytes"`
+	NetworkTxBytes  types.Int64   `tfsdk:"network_tx_bytes"`
+	BlockReadBytes  types.Int64   `tfsdk:"block_read_bytes"`
+	BlockWriteBytes types.Int64   `tfsdk:"block_write_bytes"`
+}
+
+func (d *StackStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_stats"
+}
+
+func (d *StackStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes live resource usage for each container of a Berth stack",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier in format 'server_id:stack_name'",
+				Computed:    true,
+			},
+			"server_id": schema.Int64Attribute{
+				Description: "Server ID",
+				Required:    true,
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Stack name",
+				Required:    true,
+			},
+			"total_cpu_percent": schema.Float64Attribute{
+				Description: "Sum of CPU usage across all containers, in percent",
+				Computed:    true,
+			},
+			"total_memory_usage": schema.Int64Attribute{
+				Description: "Sum of memory usage across all containers, in bytes",
+				Computed:    true,
+			},
+			"containers": schema.ListNestedAttribute{
+				Description: "Per-container statistics",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Container name",
+							Computed:    true,
+						},
+						"service_name": schema.StringAttribute{
+							Description: "Compose service name",
+							Computed:    true,
+						},
+						"cpu_percent": schema.Float64Attribute{
+							Description: "CPU usage in percent",
+							Computed:    true,
+						},
+						"memory_usage": schema.Int64Attribute{
+							Description: "Memory usage in bytes",
+							Computed:    true,
+						},
+						"memory_limit": schema.Int64Attribute{
+							Description: "Memory limit in bytes",
+							Computed:    true,
+						},
+						"memory_percent": schema.Float64Attribute{
+							Description: "Memory usage as a percentage of the limit",
+							Computed:    true,
+						},
+						"network_rx_bytes": schema.Int64Attribute{
+							Description: "Bytes received over the network",
+							Computed:    true,
+						},
+						"network_tx_bytes": schema.Int64Attribute{
+							Description: "Bytes sent over the network",
+							Computed:    true,
+						},
+						"block_read_bytes": schema.Int64Attribute{
+							Description: "Bytes read from block devices",
+							Computed:    true,
+						},
+						"block_write_bytes": schema.Int64Attribute{
+							Description: "Bytes written to block devices",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StackStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *StackStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data StackStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := uint(data.ServerID.ValueInt64())
+	stackName := data.StackName.ValueString()
+
+	stats, err := d.client.GetStackStats(ctx, serverID, stackName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read stack stats", apiErrorDetail(d.providerData, fmt.Sprintf("Stack %q on server %d", stackName, serverID), err))
+		return
+	}
+
+	var totalCPU float64
+	var totalMemory int64
+
+	data.Containers = make([]ContainerStatsModel, 0, len(stats))
+	for _, s := range stats {
+		totalCPU += s.CPUPercent
+		totalMemory += s.MemoryUsage
+
+		data.Containers = append(data.Containers, ContainerStatsModel{
+			Name:            types.StringValue(s.Name),
+			ServiceName:     types.StringValue(s.ServiceName),
+			CPUPercent:      types.Float64Value(s.CPUPercent),
+			MemoryUsage:     types.Int64Value(s.MemoryUsage),
+			MemoryLimit:     types.Int64Value(s.MemoryLimit),
+			MemoryPercent:   types.Float64Value(s.MemoryPercent),
+			NetworkRxBytes:  types.Int64Value(s.NetworkRxBytes),
+			NetworkTxBytes:  types.Int64Value(s.NetworkTxBytes),
+			BlockReadBytes:  types.Int64Value(s.BlockReadBytes),
+			BlockWriteBytes: types.Int64Value(s.BlockWriteBytes),
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", serverID, stackName))
+	data.TotalCPUPercent = types.Float64Value(totalCPU)
+	data.TotalMemoryUsage = types.Int64Value(totalMemory)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &SystemInfoDataSource{}
+
+func NewSystemInfoDataSource() datasource.DataSource {
+	return &SystemInfoDataSource{}
+}
+
+type SystemInfoDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type SystemInfoDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.String `tfsdk:"version"`
+	UserCount   types.Int64  `tfsdk:"user_count"`
+	ServerCount types.Int64  `tfsdk:"server_count"`
+	RoleCount   types.Int64  `tfsdk:"role_count"`
+}
+
+func (d *SystemInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_info"
+}
+
+func (d *SystemInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes instance-level information about the Berth server",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Berth server version",
+				Computed:    true,
+			},
+			"user_count": schema.Int64Attribute{
+				Description: "Number of users",
+				Computed:    true,
+			},
+			"server_count": schema.Int64Attribute{
+				Description: "Number of registered servers",
+				Computed:    true,
+			},
+			"role_count": schema.Int64Attribute{
+				Description: "Number of roles",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SystemInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *SystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemInfoDataSourceModel
+
+	version, err := d.client.GetVersion(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read version", apiErrorDetail(d.providerData, "Berth version", err))
+		return
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", apiErrorDetail(d.providerData, "Users", err))
+		return
+	}
+
+	servers, err := d.client.ListServers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list servers", apiErrorDetail(d.providerData, "Servers", err))
+		return
+	}
+
+	roles, err := d.client.ListRoles(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list roles", apiErrorDetail(d.providerData, "Roles", err))
+		return
+	}
+
+	data.ID = types.StringValue("system_info")
+	data.Version = types.StringValue(version)
+	data.UserCount = types.Int64Value(int64(len(users)))
+	data.ServerCount = types.Int64Value(int64(len(servers)))
+	data.RoleCount = types.Int64Value(int64(len(roles)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &UserDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &UserDataSource{}
+
+func NewUserDataSource() datasource.DataSource {
+	return &UserDataSource{}
+}
+
+type UserDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type UserDataSourceModel struct {
+	ID          types.String    `tfsdk:"id"`
+	Username    types.String    `tfsdk:"username"`
+	Email       types.String    `tfsdk:"email"`
+	TOTPEnabled types.Bool      `tfsdk:"totp_enabled"`
+	LastLoginAt types.String    `tfsdk:"last_login_at"`
+	CreatedAt   types.String    `tfsdk:"created_at"`
+	Roles       []UserRoleModel `tfsdk:"roles"`
+}
+
+type UserRoleModel struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	IsAdmin types.Bool   `tfsdk:"is_admin"`
+}
+
+func userRolesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Roles assigned to the user",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Description: "Role ID",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Role name",
+					Computed:    true,
+				},
+				"is_admin": schema.BoolAttribute{
+					Description: "Whether the role is an admin role",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func userRoleModels(roles []client.Role) []UserRoleModel {
+	models := make([]UserRoleModel, 0, len(roles))
+	for _, role := range roles {
+		models = append(models, UserRoleModel{
+			ID:      types.Int64Value(int64(role.ID)),
+			Name:    types.StringValue(role.Name),
+			IsAdmin: types.BoolValue(role.IsAdmin),
+		})
+	}
+	return models
+}
+
+func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a Berth user by email or username",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "User ID",
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username. Exactly one of email or username must be set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address. Exactly one of email or username must be set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"totp_enabled": schema.BoolAttribute{
+				Description: "Whether two-factor authentication is enabled",
+				Computed:    true,
+			},
+			"last_login_at": schema.StringAttribute{
+				Description: "Time of the user's last login, empty if the user never logged in",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time",
+				Computed:    true,
+			},
+			"roles": userRolesSchema(),
+		},
+	}
+}
+
+func (d *UserDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Email.IsUnknown() || data.Username.IsUnknown() {
+		return
+	}
+
+	if data.Email.IsNull() == data.Username.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Invalid user reference",
+			"Exactly one of email or username must be set.",
+		)
+	}
+}
+
+func (d *UserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UserDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.User
+	var err error
+	subject := fmt.Sprintf("User %q", data.Username.ValueString())
+	if !data.Email.IsNull() {
+		subject = fmt.Sprintf("User with email %q", data.Email.ValueString())
+		user, err = d.client.GetUserByEmail(ctx, data.Email.ValueString())
+	} else {
+		user, err = d.client.GetUserByUsername(ctx, data.Username.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find user", apiErrorDetail(d.providerData, subject, err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatUint(uint64(user.ID), 10))
+	data.Username = types.StringValue(user.Username)
+	data.Email = types.StringValue(user.Email)
+	data.TOTPEnabled = types.BoolValue(user.TOTPEnabled)
+	data.LastLoginAt = types.StringValue(user.LastLoginAt)
+	data.CreatedAt = types.StringValue(user.CreatedAt)
+	data.Roles = userRoleModels(user.Roles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
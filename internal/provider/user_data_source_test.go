@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func emptyUserDataSourceModel() UserDataSourceModel {
+	return UserDataSourceModel{
+		ID:          types.StringNull(),
+		Username:    types.StringNull(),
+		Email:       types.StringNull(),
+		TOTPEnabled: types.BoolNull(),
+		LastLoginAt: types.StringNull(),
+		CreatedAt:   types.StringNull(),
+	}
+}
+
+func TestUserDataSource_ByEmail(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("alice", "alice@example.com")
+	role := fake.AddRole("deployers", "")
+	if err := c.AssignUserRole(context.Background(), uint(user.ID), uint(role.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	model := emptyUserDataSourceModel()
+	model.Email = types.StringValue("alice@example.com")
+
+	state, diags := readDataSource(t, NewUserDataSource(), c, model)
+	requireNoDiags(t, diags)
+
+	var data UserDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.ID.ValueString() != strconv.Itoa(int(user.ID)) || data.Username.ValueString() != "alice" {
+		t.Fatalf("unexpected user: %+v", data)
+	}
+	if len(data.Roles) != 1 || data.Roles[0].Name.ValueString() != "deployers" {
+		t.Fatalf("unexpected roles: %+v", data.Roles)
+	}
+}
+
+func TestUserDataSource_ByUsername(t *testing.T) {
+	fake, c := newTestClient(t)
+	fake.AddUser("alice", "alice@example.com")
+	fake.AddUser("bob", "bob@example.com")
+
+	model := emptyUserDataSourceModel()
+	model.Username = types.StringValue("bob")
+
+	state, diags := readDataSource(t, NewUserDataSource(), c, model)
+	requireNoDiags(t, diags)
+
+	var data UserDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if data.Email.ValueString() != "bob@example.com" {
+		t.Fatalf("unexpected user: %+v", data)
+	}
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type UserResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	Email       types.String `tfsdk:"email"`
+	Password    types.String `tfsdk:"password"`
+	TOTPEnabled types.Bool   `tfsdk:"totp_enabled"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Berth user. Berth has no API to update or delete users, so username and email cannot be changed after creation and destroying the resource only removes it from state",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "User ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Username",
+				Required:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Initial password, only used when the user is created. Never stored in state",
+				Required:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+			},
+			"totp_enabled": schema.BoolAttribute{
+				Description: "Whether the user has enabled TOTP",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Creation time of the user",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+	var password types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateUser(ctx, data.Username.ValueString(), data.Email.ValueString(), password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create user", apiErrorDetail(r.providerData, fmt.Sprintf("User %q", data.Username.ValueString()), err))
+		return
+	}
+
+	data.setUser(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := strconv.ParseUint(data.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user ID", err.Error())
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, uint(id))
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user", apiErrorDetail(r.providerData, fmt.Sprintf("User %d", id), err))
+		return
+	}
+
+	data.setUser(user)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkFeatureSupported(ctx, r.providerData, "berth_user", client.FeatureUserManagement)...)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkImmutableUserFields(plan, state)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkImmutableUserFields(data, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Password = types.StringNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"User not deleted",
+		fmt.Sprintf("Berth does not support deleting users through the API. User '%s' was removed from Terraform state but still exists in Berth.", data.Username.ValueString()),
+	)
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func checkImmutableUserFields(plan, state UserResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, field := range []struct {
+		name         string
+		planned, old types.String
+	}{
+		{"username", plan.Username, state.Username},
+		{"email", plan.Email, state.Email},
+	} {
+		if field.planned.IsUnknown() || field.planned.Equal(field.old) {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root(field.name),
+			"Cannot change user "+field.name,
+			fmt.Sprintf("Berth has no API to update or delete users, so %s cannot change from %q to %q. Change it in Berth and update the configuration to match, or create a separate berth_user resource.", field.name, field.old.ValueString(), field.planned.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+func (m *UserResourceModel) setUser(user *client.User) {
+	m.ID = types.StringValue(strconv.FormatUint(uint64(user.ID), 10))
+	m.Username = types.StringValue(user.Username)
+	m.Email = types.StringValue(user.Email)
+	m.Password = types.StringNull()
+	m.TOTPEnabled = types.BoolValue(user.TOTPEnabled)
+	m.CreatedAt = types.StringValue(user.CreatedAt)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	h := newResourceHarness(t, NewUserResource(), c)
+
+	state := h.create(UserResourceModel{
+		ID:          types.StringUnknown(),
+		Username:    types.StringValue("alice"),
+		Email:       types.StringValue("alice@example.com"),
+		Password:    types.StringValue("correct-horse-battery-staple"),
+		TOTPEnabled: types.BoolUnknown(),
+		CreatedAt:   types.StringUnknown(),
+	})
+
+	var created UserResourceModel
+	h.get(state, &created)
+
+	users := fake.Users()
+	if len(users) != 1 || users[0].Password != "correct-horse-battery-staple" {
+		t.Fatalf("expected user to be created with the configured password, got %+v", users)
+	}
+	if !created.Password.IsNull() {
+		t.Fatal("expected password to be kept out of state")
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	h.delete(state)
+	if len(fake.Users()) != 1 {
+		t.Fatal("expected user to remain in Berth after delete")
+	}
+}
+
+func TestUserResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("bob", "bob@example.com")
+	h := newResourceHarness(t, NewUserResource(), c)
+
+	state := h.importState(strconv.Itoa(int(user.ID)))
+
+	var imported UserResourceModel
+	h.get(state, &imported)
+	if imported.Username.ValueString() != "bob" || imported.Email.ValueString() != "bob@example.com" {
+		t.Fatalf("unexpected imported user: %+v", imported)
+	}
+}
+
+func TestUserResource_RejectsIdentityChanges(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("carol", "carol@example.com")
+	h := newResourceHarness(t, NewUserResource(), c)
+
+	state := h.importState(strconv.Itoa(int(user.ID)))
+
+	var current UserResourceModel
+	h.get(state, &current)
+
+	renamed := current
+	renamed.Username = types.StringValue("caroline")
+	requireDiagnostics(t, h.modifyPlanFrom(state, renamed), "Cannot change user username", "")
+
+	_, diags := h.tryUpdate(state, renamed)
+	requireDiagnostics(t, diags, "Cannot change user username", "")
+
+	readdressed := current
+	readdressed.Email = types.StringValue("carol@example.org")
+	requireDiagnostics(t, h.modifyPlanFrom(state, readdressed), "Cannot change user email", "")
+
+	requireNoDiags(t, h.modifyPlanFrom(state, current))
+	if len(fake.Users()) != 1 {
+		t.Fatalf("expected no additional users, got %+v", fake.Users())
+	}
+}
+
+func TestUserResource_ReadRemovedUser(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("dave", "dave@example.com")
+	h := newResourceHarness(t, NewUserResource(), c)
+
+	state := h.importState(strconv.Itoa(int(user.ID)))
+	fake.RemoveUser(user.ID)
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected a user deleted outside Terraform to be removed from state")
+	}
+}
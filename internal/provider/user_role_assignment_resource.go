@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ resource.Resource = &UserRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &UserRoleAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &UserRoleAssignmentResource{}
+
+func NewUserRoleAssignmentResource() resource.Resource {
+	return &UserRoleAssignmentResource{}
+}
+
+type UserRoleAssignmentResource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type UserRoleAssignmentResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	UserID types.Int64  `tfsdk:"user_id"`
+	Email  types.String `tfsdk:"email"`
+	RoleID types.Int64  `tfsdk:"role_id"`
+}
+
+func (r *UserRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_role_assignment"
+}
+
+func (r *UserRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a Berth role to a user",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Resource ID in format 'user_id:role_id'",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Description: "User ID. Exactly one of user_id or email must be set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email of the user. Exactly one of user_id or email must be set",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				Description: "Role ID",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserRoleAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.UserID.IsUnknown() || data.Email.IsUnknown() {
+		return
+	}
+
+	if data.UserID.IsNull() == data.Email.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_id"),
+			"Invalid user reference",
+			"Exactly one of user_id or email must be set.",
+		)
+	}
+}
+
+func (r *UserRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+func (r *UserRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *client.User
+	var err error
+	subject := fmt.Sprintf("User with email %q", data.Email.ValueString())
+	if !data.UserID.IsNull() && !data.UserID.IsUnknown() {
+		subject = fmt.Sprintf("User %d", data.UserID.ValueInt64())
+		user, err = r.client.GetUser(ctx, uint(data.UserID.ValueInt64()))
+	} else {
+		user, err = r.client.GetUserByEmail(ctx, data.Email.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to find user", apiErrorDetail(r.providerData, subject, err))
+		return
+	}
+
+	roleID := uint(data.RoleID.ValueInt64())
+
+	if err := r.client.AssignUserRole(ctx, user.ID, roleID); err != nil {
+		resp.Diagnostics.AddError("Failed to assign role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d for user %d", roleID, user.ID), err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%d", user.ID, roleID))
+	data.UserID = types.Int64Value(int64(user.ID))
+	data.Email = types.StringValue(user.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, uint(data.UserID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read user", apiErrorDetail(r.providerData, fmt.Sprintf("User %d", data.UserID.ValueInt64()), err))
+		return
+	}
+
+	assigned := false
+	for _, role := range user.Roles {
+		if role.ID == uint(data.RoleID.ValueInt64()) {
+			assigned = true
+			break
+		}
+	}
+
+	if !assigned {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Email = types.StringValue(user.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserRoleAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RevokeUserRole(ctx, uint(data.UserID.ValueInt64()), uint(data.RoleID.ValueInt64())); err != nil {
+		resp.Diagnostics.AddError("Failed to revoke role", apiErrorDetail(r.providerData, fmt.Sprintf("Role %d for user %d", data.RoleID.ValueInt64(), data.UserID.ValueInt64()), err))
+		return
+	}
+}
+
+func (r *UserRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Import ID must be in format 'user_id:role_id'",
+		)
+		return
+	}
+
+	userID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid user ID", err.Error())
+		return
+	}
+
+	roleID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid role ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), roleID)...)
+}
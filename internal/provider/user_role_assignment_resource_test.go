@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUserRoleAssignmentResource_CRUD(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("alice", "alice@example.com")
+	role := fake.AddRole("deployers", "")
+	h := newResourceHarness(t, NewUserRoleAssignmentResource(), c)
+
+	state := h.create(UserRoleAssignmentResourceModel{
+		ID:     types.StringUnknown(),
+		UserID: types.Int64Unknown(),
+		Email:  types.StringValue("alice@example.com"),
+		RoleID: types.Int64Value(int64(role.ID)),
+	})
+
+	var created UserRoleAssignmentResourceModel
+	h.get(state, &created)
+	if created.ID.ValueString() != fmt.Sprintf("%d:%d", user.ID, role.ID) || created.UserID.ValueInt64() != int64(user.ID) {
+		t.Fatalf("unexpected assignment: %+v", created)
+	}
+	if roles := fake.Users()[0].RoleIDs; len(roles) != 1 || roles[0] != role.ID {
+		t.Fatalf("expected role to be assigned, got %v", roles)
+	}
+
+	state, diags := h.read(state)
+	requireNoDiags(t, diags)
+
+	h.delete(state)
+	if roles := fake.Users()[0].RoleIDs; len(roles) != 0 {
+		t.Fatalf("expected role to be revoked, got %v", roles)
+	}
+
+	state, diags = h.read(state)
+	requireNoDiags(t, diags)
+	if !state.Raw.IsNull() {
+		t.Fatal("expected revoked assignment to be removed from state")
+	}
+}
+
+func TestUserRoleAssignmentResource_Import(t *testing.T) {
+	fake, c := newTestClient(t)
+	user := fake.AddUser("alice", "alice@example.com")
+	role := fake.AddRole("deployers", "")
+	h := newResourceHarness(t, NewUserRoleAssignmentResource(), c)
+
+	if err := c.AssignUserRole(context.Background(), uint(user.ID), uint(role.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	state := h.importState(fmt.Sprintf("%d:%d", user.ID, role.ID))
+
+	var imported UserRoleAssignmentResourceModel
+	h.get(state, &imported)
+	if imported.Email.ValueString() != "alice@example.com" {
+		t.Fatalf("unexpected imported assignment: %+v", imported)
+	}
+}
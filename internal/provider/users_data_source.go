@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tech-arch1tect/terraform-provider-berth/internal/client"
+)
+
+var _ datasource.DataSource = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+type UsersDataSource struct {
+	client       client.BerthAPI
+	providerData *ProviderData
+}
+
+type UsersDataSourceModel struct {
+	ID         types.String       `tfsdk:"id"`
+	EmailRegex types.String       `tfsdk:"email_regex"`
+	RoleName   types.String       `tfsdk:"role_name"`
+	IDs        []types.Int64      `tfsdk:"ids"`
+	Users      []UserSummaryModel `tfsdk:"users"`
+}
+
+type UserSummaryModel struct {
+	ID          types.Int64     `tfsdk:"id"`
+	Username    types.String    `tfsdk:"username"`
+	Email       types.String    `tfsdk:"email"`
+	TOTPEnabled types.Bool      `tfsdk:"totp_enabled"`
+	LastLoginAt types.String    `tfsdk:"last_login_at"`
+	CreatedAt   types.String    `tfsdk:"created_at"`
+	Roles       []UserRoleModel `tfsdk:"roles"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Berth users, optionally filtered",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Data source identifier",
+				Computed:    true,
+			},
+			"email_regex": schema.StringAttribute{
+				Description: "Regular expression (RE2) that user emails must match",
+				Optional:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Description: "Only return users that have the role with this name",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "IDs of the matching users",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Matching users",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "User ID",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Username",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email address",
+							Computed:    true,
+						},
+						"totp_enabled": schema.BoolAttribute{
+							Description: "Whether two-factor authentication is enabled",
+							Computed:    true,
+						},
+						"last_login_at": schema.StringAttribute{
+							Description: "Time of the user's last login, empty if the user never logged in",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "Creation time",
+							Computed:    true,
+						},
+						"roles": userRolesSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*ProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+	d.providerData = providerData
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emailRegex *regexp.Regexp
+	if !data.EmailRegex.IsNull() {
+		var err error
+		emailRegex, err = regexp.Compile(data.EmailRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email_regex"), "Invalid email_regex", err.Error())
+			return
+		}
+	}
+
+	users, err := d.client.ListUsers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list users", apiErrorDetail(d.providerData, "Users", err))
+		return
+	}
+
+	data.IDs = make([]types.Int64, 0, len(users))
+	data.Users = make([]UserSummaryModel, 0, len(users))
+	for _, user := range users {
+		if emailRegex != nil && !emailRegex.MatchString(user.Email) {
+			continue
+		}
+		if !data.RoleName.IsNull() && !hasRoleNamed(user.Roles, data.RoleName.ValueString()) {
+			continue
+		}
+
+		data.IDs = append(data.IDs, types.Int64Value(int64(user.ID)))
+		data.Users = append(data.Users, UserSummaryModel{
+			ID:          types.Int64Value(int64(user.ID)),
+			Username:    types.StringValue(user.Username),
+			Email:       types.StringValue(user.Email),
+			TOTPEnabled: types.BoolValue(user.TOTPEnabled),
+			LastLoginAt: types.StringValue(user.LastLoginAt),
+			CreatedAt:   types.StringValue(user.CreatedAt),
+			Roles:       userRoleModels(user.Roles),
+		})
+	}
+
+	data.ID = types.StringValue("users")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func hasRoleNamed(roles []client.Role, name string) bool {
+	for _, role := range roles {
+		if role.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUsersDataSource_Filters(t *testing.T) {
+	fake, c := newTestClient(t)
+	alice := fake.AddUser("alice", "alice@corp.example.com")
+	bob := fake.AddUser("bob", "bob@corp.example.com")
+	fake.AddUser("carol", "carol@contractor.example.com")
+	role := fake.AddRole("deployers", "")
+	if err := c.AssignUserRole(context.Background(), uint(bob.ID), uint(role.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	state, diags := readDataSource(t, NewUsersDataSource(), c, UsersDataSourceModel{
+		ID:         types.StringNull(),
+		EmailRegex: types.StringValue(`@corp\.example\.com$`),
+		RoleName:   types.StringNull(),
+	})
+	requireNoDiags(t, diags)
+
+	var data UsersDataSourceModel
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if len(data.IDs) != 2 || data.IDs[0].ValueInt64() != int64(alice.ID) || data.IDs[1].ValueInt64() != int64(bob.ID) {
+		t.Fatalf("unexpected ids: %v", data.IDs)
+	}
+
+	state, diags = readDataSource(t, NewUsersDataSource(), c, UsersDataSourceModel{
+		ID:         types.StringNull(),
+		EmailRegex: types.StringNull(),
+		RoleName:   types.StringValue("deployers"),
+	})
+	requireNoDiags(t, diags)
+
+	requireNoDiags(t, state.Get(context.Background(), &data))
+	if len(data.Users) != 1 || data.Users[0].Username.ValueString() != "bob" {
+		t.Fatalf("unexpected users: %+v", data.Users)
+	}
+}